@@ -0,0 +1,349 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/ashmrtn/commentmimic/pkg/commentmimic"
+)
+
+const (
+	formatFlag  = "format"
+	formatText  = "text"
+	formatSARIF = "sarif"
+
+	loadMode = packages.NeedName |
+		packages.NeedFiles |
+		packages.NeedSyntax |
+		packages.NeedTypes |
+		packages.NeedTypesInfo |
+		packages.NeedTypesSizes |
+		packages.NeedImports
+)
+
+// driverValueFlags are the flags of the standard driver that take a value. The
+// driver only registers them once it parses the command line so they can't be
+// looked up beforehand.
+var driverValueFlags = map[string]struct{}{
+	"c":          {},
+	"cpuprofile": {},
+	"debug":      {},
+	"memprofile": {},
+	"tags":       {},
+	"trace":      {},
+}
+
+// takesValue returns true if the flag name reads its value from the next arg
+// when it's given without "=". Flags in fs are checked along with the flags of
+// the standard driver.
+func takesValue(fs *flag.FlagSet, name string) bool {
+	if _, ok := driverValueFlags[name]; ok || name == formatFlag {
+		return true
+	}
+
+	f := fs.Lookup(name)
+	if f == nil {
+		return false
+	}
+
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+
+	return !ok || !b.IsBoolFlag()
+}
+
+// flagArg is a flag given on the command line.
+type flagArg struct {
+	name     string
+	value    string
+	hasValue bool
+	// args holds the args the flag was given in, which includes the next arg if
+	// it's the value of the flag.
+	args []string
+}
+
+// splitFlagArgs splits args into the flags before the first non-flag argument
+// and the remaining args. Flags in fs and flags of the standard driver that
+// take a value and aren't given with "=" take the next arg as their value so it
+// doesn't end the flags.
+func splitFlagArgs(fs *flag.FlagSet, args []string) ([]flagArg, []string) {
+	var res []flagArg
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			return res, args[i:]
+		}
+
+		fa := flagArg{
+			name: strings.TrimLeft(arg, "-"),
+			args: []string{arg},
+		}
+
+		if idx := strings.Index(fa.name, "="); idx >= 0 {
+			fa.name, fa.value = fa.name[:idx], fa.name[idx+1:]
+			fa.hasValue = true
+		}
+
+		if !fa.hasValue && takesValue(fs, fa.name) && i+1 < len(args) {
+			i++
+			fa.value = args[i]
+			fa.hasValue = true
+			fa.args = append(fa.args, args[i])
+		}
+
+		res = append(res, fa)
+	}
+
+	return res, nil
+}
+
+// flagGiven returns true if the flag name is given in args.
+func flagGiven(fs *flag.FlagSet, args []string, name string) bool {
+	flags, _ := splitFlagArgs(fs, args)
+
+	for _, fa := range flags {
+		if fa.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// extractFormat removes the format flag from args and returns its value along
+// with the remaining args. The text format is returned if the flag isn't
+// given. Only args before the first non-flag argument are checked. Values of
+// the other flags, either in fs or flags of the standard driver, are skipped
+// so they don't end the scan.
+func extractFormat(
+	fs *flag.FlagSet,
+	args []string,
+) (string, []string, error) {
+	format := formatText
+	res := make([]string, 0, len(args))
+
+	flags, rest := splitFlagArgs(fs, args)
+
+	for _, fa := range flags {
+		if fa.name != formatFlag {
+			res = append(res, fa.args...)
+			continue
+		}
+
+		if !fa.hasValue {
+			return "", nil, fmt.Errorf("flag needs an argument: -%s", formatFlag)
+		}
+
+		format = fa.value
+	}
+
+	res = append(res, rest...)
+
+	switch format {
+	case formatText, formatSARIF:
+		return format, res, nil
+	default:
+		return "", nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// analyzerRunner runs an analyzer and the analyzers it requires on a single
+// package. Only diagnostics from target are sent to report.
+type analyzerRunner struct {
+	pkg     *packages.Package
+	target  *analysis.Analyzer
+	report  func(analysis.Diagnostic)
+	results map[*analysis.Analyzer]any
+}
+
+func (r *analyzerRunner) run(a *analysis.Analyzer) (any, error) {
+	if res, ok := r.results[a]; ok {
+		return res, nil
+	}
+
+	resultOf := map[*analysis.Analyzer]any{}
+
+	for _, req := range a.Requires {
+		res, err := r.run(req)
+		if err != nil {
+			return nil, err
+		}
+
+		resultOf[req] = res
+	}
+
+	report := func(analysis.Diagnostic) {}
+	if a == r.target {
+		report = r.report
+	}
+
+	pass := &analysis.Pass{
+		Analyzer:   a,
+		Fset:       r.pkg.Fset,
+		Files:      r.pkg.Syntax,
+		OtherFiles: r.pkg.OtherFiles,
+		Pkg:        r.pkg.Types,
+		TypesInfo:  r.pkg.TypesInfo,
+		TypesSizes: r.pkg.TypesSizes,
+		ResultOf:   resultOf,
+		Report:     report,
+	}
+
+	res, err := a.Run(pass)
+	if err != nil {
+		return nil, fmt.Errorf("analyzing %s: %w", r.pkg.ID, err)
+	}
+
+	if r.results == nil {
+		r.results = map[*analysis.Analyzer]any{}
+	}
+
+	r.results[a] = res
+
+	return res, nil
+}
+
+// analyzePackages analyzes the packages given in args with a and sends the
+// findings to report. A baseline requested with the write baseline flag is
+// written once all packages are analyzed. Returns the exit code for the
+// program.
+func analyzePackages(
+	a *analysis.Analyzer,
+	args []string,
+	report func(*token.FileSet, analysis.Diagnostic),
+) int {
+	fs := flag.NewFlagSet(a.Name, flag.ContinueOnError)
+	tests := fs.Bool(
+		"test",
+		true,
+		"indicates whether test files should be analyzed, too",
+	)
+
+	a.Flags.VisitAll(func(f *flag.Flag) {
+		fs.Var(f.Value, f.Name, f.Usage)
+	})
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if fs.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "%s: no packages given\n", a.Name)
+		return 2
+	}
+
+	pkgs, err := packages.Load(
+		&packages.Config{Mode: loadMode, Tests: *tests},
+		fs.Args()...,
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", a.Name, err)
+		return 1
+	}
+
+	if packages.PrintErrors(pkgs) > 0 {
+		return 1
+	}
+
+	type key struct {
+		pos     token.Position
+		message string
+	}
+
+	// Packages with test files are analyzed more than once so skip duplicate
+	// findings.
+	seen := map[key]struct{}{}
+
+	for _, pkg := range pkgs {
+		// Skip the generated main package for tests.
+		if strings.HasSuffix(pkg.ID, ".test") {
+			continue
+		}
+
+		pkg := pkg
+		runner := &analyzerRunner{
+			pkg:    pkg,
+			target: a,
+			report: func(d analysis.Diagnostic) {
+				k := key{pos: pkg.Fset.Position(d.Pos), message: d.Message}
+				if _, ok := seen[k]; ok {
+					return
+				}
+
+				seen[k] = struct{}{}
+
+				report(pkg.Fset, d)
+			},
+		}
+
+		if _, err := runner.run(a); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", a.Name, err)
+			return 1
+		}
+	}
+
+	if err := commentmimic.WriteBaseline(a); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", a.Name, err)
+		return 1
+	}
+
+	return 0
+}
+
+// runText analyzes the packages given in args with a and writes the findings
+// to out in the same format as the standard driver. Returns the exit code for
+// the program.
+func runText(a *analysis.Analyzer, args []string, out io.Writer) int {
+	found := false
+
+	code := analyzePackages(
+		a,
+		args,
+		func(fset *token.FileSet, d analysis.Diagnostic) {
+			found = true
+
+			fmt.Fprintf(out, "%s: %s\n", fset.Position(d.Pos), d.Message)
+		},
+	)
+	if code != 0 {
+		return code
+	}
+
+	// Match the exit code of the standard driver when there are findings.
+	if found {
+		return 3
+	}
+
+	return 0
+}
+
+// runSARIF analyzes the packages given in args with a and writes the findings
+// to out in SARIF format. Returns the exit code for the program.
+func runSARIF(a *analysis.Analyzer, args []string, out io.Writer) int {
+	root, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", a.Name, err)
+		return 1
+	}
+
+	w := newSARIFWriter(root)
+
+	if code := analyzePackages(a, args, w.add); code != 0 {
+		return code
+	}
+
+	if err := w.write(out); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", a.Name, err)
+		return 1
+	}
+
+	return 0
+}
@@ -7,6 +7,7 @@ require (
 	github.com/stretchr/testify v1.8.0
 	golang.org/x/sys v0.7.0
 	golang.org/x/tools v0.8.0
+	gopkg.in/yaml.v3 v3.0.1
 	gotest.tools/gotestsum v1.8.2
 )
 
@@ -23,5 +24,4 @@ require (
 	golang.org/x/mod v0.10.0 // indirect
 	golang.org/x/sync v0.1.0 // indirect
 	golang.org/x/term v0.0.0-20220526004731-065cf7ba2467 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
 	"golang.org/x/tools/go/analysis/singlechecker"
 
 	"github.com/ashmrtn/commentmimic/pkg/commentmimic"
@@ -8,5 +11,28 @@ import (
 
 func main() {
 	a := commentmimic.New()
+
+	// The format is handled before the flags of the analyzer are parsed. It's
+	// registered so it shows up in the help output.
+	a.Flags.String(formatFlag, formatText, "output format, one of text or sarif")
+
+	format, args, err := extractFormat(&a.Flags, os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", a.Name, err)
+		os.Exit(2)
+	}
+
+	if format == formatSARIF {
+		os.Exit(runSARIF(a, args, os.Stdout))
+	}
+
+	// The standard driver analyzes each package on its own so it has no place
+	// to write the baseline once all packages are analyzed.
+	if flagGiven(&a.Flags, args, commentmimic.WriteBaselineFlag) {
+		os.Exit(runText(a, args, os.Stderr))
+	}
+
+	// The text format is handled by the standard driver.
+	os.Args = append(os.Args[:1], args...)
 	singlechecker.Main(a)
 }
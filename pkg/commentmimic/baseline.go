@@ -0,0 +1,365 @@
+package commentmimic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	staleBaselineTmpl = "stale baseline entry for '%s' (%s): " +
+		"found %d of %d findings in %s"
+
+	ruleStaleBaseline = "stale-baseline"
+)
+
+// baselineEntry is a set of findings recorded in a baseline file. Entries
+// aren't tied to line numbers so they still match after unrelated changes to
+// the file. Receiver is the type methods and interface methods belong to so
+// methods with the same name on different types get their own entries. Count
+// is the number of findings for the element and rule in the file.
+type baselineEntry struct {
+	Package  string `json:"package"`
+	File     string `json:"file"`
+	Receiver string `json:"receiver,omitempty"`
+	Element  string `json:"element"`
+	Rule     string `json:"rule"`
+	Count    int    `json:"count"`
+}
+
+// baselineFile is the layout of a baseline file.
+type baselineFile struct {
+	Entries []baselineEntry `json:"entries"`
+}
+
+type baselineKey struct {
+	pkg      string
+	file     string
+	receiver string
+	element  string
+	rule     string
+}
+
+// displayName returns the name of the element of key qualified by its receiver
+// if it has one, like T.Close.
+func (key baselineKey) displayName() string {
+	if len(key.receiver) == 0 {
+		return key.element
+	}
+
+	return key.receiver + "." + key.element
+}
+
+// less returns true if key sorts before other. Keys are sorted by package,
+// file, receiver, element, and then rule.
+func (key baselineKey) less(other baselineKey) bool {
+	if key.pkg != other.pkg {
+		return key.pkg < other.pkg
+	}
+
+	if key.file != other.file {
+		return key.file < other.file
+	}
+
+	if key.receiver != other.receiver {
+		return key.receiver < other.receiver
+	}
+
+	if key.element != other.element {
+		return key.element < other.element
+	}
+
+	return key.rule < other.rule
+}
+
+// baselineWriteFlag is the value of the write baseline flag. It holds on to the
+// baseline so WriteBaseline can find it from the flags of the analyzer.
+type baselineWriteFlag struct {
+	b *baseline
+}
+
+func (f baselineWriteFlag) String() string {
+	if f.b == nil {
+		return ""
+	}
+
+	return f.b.writePath
+}
+
+func (f baselineWriteFlag) Set(s string) error {
+	f.b.writePath = s
+	return nil
+}
+
+// WriteBaseline writes the findings recorded by a, which must be an analyzer
+// returned by New, to the file given with the write baseline flag. Findings of
+// all packages analyzed so far are written, so drivers call it once after all
+// packages are analyzed. Does nothing if no baseline is being written.
+func WriteBaseline(a *analysis.Analyzer) error {
+	f := a.Flags.Lookup(WriteBaselineFlag)
+	if f == nil {
+		return fmt.Errorf("analyzer %s doesn't support baselines", a.Name)
+	}
+
+	v, ok := f.Value.(baselineWriteFlag)
+	if !ok || !v.b.writing() {
+		return nil
+	}
+
+	return v.b.write()
+}
+
+// baseline holds the state of the baseline across all packages being
+// analyzed. Packages can be analyzed in parallel so all access goes through mu.
+type baseline struct {
+	// path of the baseline file to read findings from.
+	path string
+	// writePath is the path of the baseline file to write findings to. If set,
+	// findings are recorded instead of reported.
+	writePath string
+
+	once    sync.Once
+	loadErr error
+
+	mu     sync.Mutex
+	counts map[baselineKey]int
+	// found holds the positions of findings matched to each key. Positions are
+	// tracked so packages that are analyzed more than once, like the test
+	// variant of a package, don't count the same finding twice.
+	found map[baselineKey]map[token.Position]struct{}
+}
+
+func (b *baseline) enabled() bool {
+	return len(b.path) > 0 || len(b.writePath) > 0
+}
+
+func (b *baseline) writing() bool {
+	return len(b.writePath) > 0
+}
+
+func (b *baseline) load() error {
+	b.once.Do(func() {
+		b.counts = map[baselineKey]int{}
+		b.found = map[baselineKey]map[token.Position]struct{}{}
+
+		// Start from an empty baseline when writing a new one.
+		if b.writing() {
+			return
+		}
+
+		data, err := os.ReadFile(b.path)
+		if err != nil {
+			b.loadErr = fmt.Errorf("reading baseline file: %w", err)
+			return
+		}
+
+		bf := baselineFile{}
+
+		if err := json.Unmarshal(data, &bf); err != nil {
+			b.loadErr = fmt.Errorf("parsing baseline file %s: %w", b.path, err)
+			return
+		}
+
+		for _, e := range bf.Entries {
+			key := baselineKey{
+				pkg:      e.Package,
+				file:     e.File,
+				receiver: e.Receiver,
+				element:  e.Element,
+				rule:     e.Rule,
+			}
+
+			b.counts[key] += e.Count
+		}
+	})
+
+	return b.loadErr
+}
+
+// write saves all findings recorded so far to the baseline file. Entries are
+// sorted so the file is stable between runs.
+func (b *baseline) write() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	keys := make([]baselineKey, 0, len(b.found))
+	for key := range b.found {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].less(keys[j])
+	})
+
+	bf := baselineFile{Entries: make([]baselineEntry, 0, len(keys))}
+
+	for _, key := range keys {
+		bf.Entries = append(bf.Entries, baselineEntry{
+			Package:  key.pkg,
+			File:     key.file,
+			Receiver: key.receiver,
+			Element:  key.element,
+			Rule:     key.rule,
+			Count:    len(b.found[key]),
+		})
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(bf); err != nil {
+		return fmt.Errorf("encoding baseline: %w", err)
+	}
+
+	if err := os.WriteFile(b.writePath, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing baseline file: %w", err)
+	}
+
+	return nil
+}
+
+// baselinePass matches the findings of a single package against the baseline.
+type baselinePass struct {
+	b    *baseline
+	pass *analysis.Pass
+	// matched is the number of findings in this package matched to each key.
+	matched map[baselineKey]int
+}
+
+func (b *baseline) forPass(pass *analysis.Pass) (*baselinePass, error) {
+	if !b.enabled() {
+		return nil, nil
+	}
+
+	if err := b.load(); err != nil {
+		return nil, err
+	}
+
+	return &baselinePass{
+		b:       b,
+		pass:    pass,
+		matched: map[baselineKey]int{},
+	}, nil
+}
+
+// suppress returns true if the finding d for element is in the baseline.
+// receiver is the type element belongs to if it's a method. When writing a
+// baseline all findings are recorded and suppressed.
+func (bp *baselinePass) suppress(
+	receiver string,
+	element string,
+	d analysis.Diagnostic,
+) bool {
+	if bp == nil {
+		return false
+	}
+
+	pos := bp.pass.Fset.Position(d.Pos)
+	key := baselineKey{
+		pkg:      bp.pass.Pkg.Path(),
+		file:     filepath.Base(pos.Filename),
+		receiver: receiver,
+		element:  element,
+		rule:     d.Category,
+	}
+
+	bp.b.mu.Lock()
+	defer bp.b.mu.Unlock()
+
+	positions := bp.b.found[key]
+	if positions == nil {
+		positions = map[token.Position]struct{}{}
+		bp.b.found[key] = positions
+	}
+
+	_, seen := positions[pos]
+
+	if !seen && !bp.b.writing() && len(positions) >= bp.b.counts[key] {
+		return false
+	}
+
+	positions[pos] = struct{}{}
+	bp.matched[key]++
+
+	return true
+}
+
+// finish reports entries of the baseline for files in this package that have
+// more findings recorded than were found so the baseline can be pruned. Does
+// nothing when writing a baseline since WriteBaseline writes it once all
+// packages are analyzed.
+func (bp *baselinePass) finish() error {
+	if bp == nil || bp.b.writing() {
+		return nil
+	}
+
+	files := map[string]token.Pos{}
+
+	for _, f := range bp.pass.Files {
+		if tf := bp.pass.Fset.File(f.Pos()); tf != nil {
+			files[filepath.Base(tf.Name())] = f.Package
+		}
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	pkgDir := filepath.Dir(
+		bp.pass.Fset.Position(bp.pass.Files[0].Pos()).Filename,
+	)
+
+	// Counts from the baseline file aren't changed after loading it so they can
+	// be read without holding the lock.
+	var stale []baselineKey
+
+	for key, count := range bp.b.counts {
+		if key.pkg != bp.pass.Pkg.Path() || bp.matched[key] >= count {
+			continue
+		}
+
+		// The file may belong to another variant of this package, like the test
+		// variant. Only report it here if the file no longer exists.
+		if _, ok := files[key.file]; !ok {
+			if _, err := os.Stat(filepath.Join(pkgDir, key.file)); err == nil {
+				continue
+			}
+		}
+
+		stale = append(stale, key)
+	}
+
+	sort.Slice(stale, func(i, j int) bool {
+		return stale[i].less(stale[j])
+	})
+
+	for _, key := range stale {
+		pos, ok := files[key.file]
+		if !ok {
+			pos = bp.pass.Files[0].Package
+		}
+
+		bp.pass.Report(analysis.Diagnostic{
+			Pos:      pos,
+			Category: ruleStaleBaseline,
+			Message: fmt.Sprintf(
+				staleBaselineTmpl,
+				key.displayName(),
+				key.rule,
+				bp.matched[key],
+				bp.b.counts[key],
+				key.file,
+			),
+		})
+	}
+
+	return nil
+}
@@ -1,10 +1,16 @@
 package commentmimic
 
 import (
+	"bytes"
 	"flag"
+	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
+	"os"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -14,28 +20,97 @@ import (
 const (
 	commentMismatchTmpl = "first word of comment is '%s' instead of '%s'"
 	commentEmptyTmpl    = "empty comment on '%s'"
-	commentMissingTmpl  = "exported element '%s' should be commented"
+	commentMissingTmpl  = "%s element '%s' should be commented"
+	missingDetailTmpl   = " (%s)"
+	aliasTargetTmpl     = "comment on alias '%s' should refer to '%s' or " +
+		"have a Deprecated paragraph"
+	typeBlockConflictTmpl = "comment on type block contradicts the comment " +
+		"on '%s'"
+
+	replaceWordFixTmpl = "replace '%s' with '%s'"
+	stubCommentFixTmpl = "add stub comment for '%s'"
+	stubCommentTmpl    = "// %s ...\n%s"
+
+	deprecatedPrefix = "Deprecated: "
+
+	exportedVisibility   = "exported"
+	unexportedVisibility = "unexported"
 
 	testFileNameSuffix = "_test.go"
+
+	// Rules are used as the category of diagnostics so they can be referred to
+	// by suppression directives.
+	ruleMismatch = "mismatch"
+	ruleEmpty    = "empty"
+	ruleMissing  = "missing"
+
+	ruleAliasTarget   = "alias-target"
+	ruleBlockConflict = "block-conflict"
 )
 
-var (
-	testCommentPrefix = []string{
-		"Benchmark",
-		"Example",
-		"Fuzz",
-		"Test",
+// reporter sends diagnostics about elements to a pass. Diagnostics suppressed
+// by ignore directives or the baseline aren't reported.
+type reporter struct {
+	pass     *analysis.Pass
+	ignores  *ignoreDirectives
+	baseline *baselinePass
+	// docs holds the comments go/doc associates with elements. Nil unless go/doc
+	// mode is enabled.
+	docs *goDocs
+	// comments holds the comment groups of each file sorted by position.
+	comments map[*token.File][]*ast.CommentGroup
+	// words normalizes words in comments before comparing them to element names.
+	words wordNormalizer
+	// articles checks that "A" and "An" lead words agree with the element name.
+	articles bool
+	// trailing reports comments at the end of the line of elements that were
+	// meant as their doc comment.
+	trailing bool
+	// receiver is the name of the type the method being checked belongs to and
+	// is empty for other elements. Findings are recorded in the baseline with it
+	// so methods with the same name on different types are told apart.
+	receiver string
+	// missingDetail says why the element being checked needs a comment if it's
+	// not only because it's exported. It's added to missing comment findings.
+	missingDetail string
+	// misplaced holds the positions of elements with a comment that isn't
+	// attached to them, like orphaned or trailing comments. These elements
+	// aren't reported as missing a comment since the misplaced comment is
+	// reported instead.
+	misplaced map[token.Pos]struct{}
+	// sources holds the contents of the files read for suggested fixes so each
+	// file is only read once. Files that couldn't be read map to nil.
+	sources map[*token.File][]byte
+}
+
+func (r *reporter) report(elementName string, d analysis.Diagnostic) {
+	if r.ignores.suppress(d) ||
+		r.baseline.suppress(r.receiver, elementName, d) {
+		return
 	}
 
-	// This will enforce capitalization of the first word as well.
-	structLeadWords = map[string]struct{}{
-		"A":  {},
-		"An": {},
+	r.pass.Report(d)
+}
+
+// source returns the contents of the file f. Each file is only read once.
+// Returns false if f can't be read.
+func (r *reporter) source(f *token.File) ([]byte, bool) {
+	src, ok := r.sources[f]
+	if !ok {
+		var err error
+
+		if src, err = os.ReadFile(f.Name()); err != nil {
+			src = nil
+		}
+
+		r.sources[f] = src
 	}
-)
+
+	return src, src != nil
+}
 
 func checkComment(
-	pass *analysis.Pass,
+	r *reporter,
 	commentExported bool,
 	commentAllExported bool,
 	elementName string,
@@ -46,14 +121,14 @@ func checkComment(
 	leadWords map[string]struct{},
 ) {
 	checkCommentMismatch(
-		pass,
+		r,
 		elementName,
 		comment,
 		elementPos,
 		leadWords,
 	)
 	checkExported(
-		pass,
+		r,
 		commentExported,
 		commentAllExported,
 		elementName,
@@ -92,9 +167,94 @@ func containsOnlyMachineReadableComment(comment *ast.CommentGroup) bool {
 	return onlyMachine
 }
 
+// checkCommentEmpty reports to r if comment is empty or contains only
+// whitespace. It returns true if comment has some text that can be checked
+// further.
+//
+// Comments that are only machine readable comments are not reported, but false
+// is still returned for them.
+func checkCommentEmpty(
+	r *reporter,
+	elementName string,
+	comment *ast.CommentGroup,
+	elementPos token.Pos,
+) bool {
+	// This comment could be a machine-readable comment of the form
+	// //something:else, an empty comment, or a comment containing only
+	// whitespace.
+	if len(comment.Text()) > 0 {
+		return true
+	}
+
+	if !containsOnlyMachineReadableComment(comment) {
+		// Empty comment.
+		r.report(elementName, analysis.Diagnostic{
+			Pos:      elementPos,
+			Category: ruleEmpty,
+			Message:  fmt.Sprintf(commentEmptyTmpl, elementName),
+		})
+	}
+
+	return false
+}
+
+// commentWords returns the whitespace separated words in the text of comment.
+func commentWords(comment *ast.CommentGroup) []string {
+	return strings.Fields(strings.TrimSpace(comment.Text()))
+}
+
+// commentWordPos returns the start and end positions of the word at index idx
+// of the words returned by commentWords. Lines of the comment that
+// ast.CommentGroup.Text drops, like machine-readable comments, are skipped so
+// the index of the word is the same for both. Returns false if there's no word
+// at idx.
+func commentWordPos(
+	comment *ast.CommentGroup,
+	idx int,
+) (token.Pos, token.Pos, bool) {
+	for _, c := range comment.List {
+		single := &ast.CommentGroup{List: []*ast.Comment{c}}
+		if len(strings.TrimSpace(single.Text())) == 0 {
+			continue
+		}
+
+		// Text of the comment starts after the comment marker, which is always two
+		// characters.
+		text := extractSingleCommentText(c.Text)
+		offset := 2
+
+		for len(text) > 0 {
+			trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+			offset += len(text) - len(trimmed)
+			text = trimmed
+
+			if len(text) == 0 {
+				break
+			}
+
+			wordLen := strings.IndexFunc(text, unicode.IsSpace)
+			if wordLen < 0 {
+				wordLen = len(text)
+			}
+
+			if idx == 0 {
+				start := c.Pos() + token.Pos(offset)
+				return start, start + token.Pos(wordLen), true
+			}
+
+			idx--
+			offset += wordLen
+			text = text[wordLen:]
+		}
+	}
+
+	return token.NoPos, token.NoPos, false
+}
+
 // checkCommentMismatch checks if the element with the given name has a first or
 // second word that matches the element name. If it doesn't it reports the
-// result to pass.
+// result to r along with a fix that replaces the mismatched word with the
+// element name.
 //
 // Comments that are only machine readable comments are ignored.
 //
@@ -102,7 +262,7 @@ func containsOnlyMachineReadableComment(comment *ast.CommentGroup) bool {
 // non-nil and non-empty this function will check if the second word matches the
 // element name if the first word doesn't match.
 func checkCommentMismatch(
-	pass *analysis.Pass,
+	r *reporter,
 	elementName string,
 	comment *ast.CommentGroup,
 	elementPos token.Pos,
@@ -112,25 +272,11 @@ func checkCommentMismatch(
 		return
 	}
 
-	text := comment.Text()
-
-	// This comment could be a machine-readable comment of the form
-	// //something:else, an empty comment, or a comment containing only
-	// whitespace.
-	if len(text) == 0 {
-		if !containsOnlyMachineReadableComment(comment) {
-			// Empty comment.
-			pass.Reportf(
-				elementPos,
-				commentEmptyTmpl,
-				elementName,
-			)
-		}
-
+	if !checkCommentEmpty(r, elementName, comment, elementPos) {
 		return
 	}
 
-	words := strings.Fields(strings.TrimSpace(text))
+	words := commentWords(comment)
 
 	// Set to empty if there's no non-whitespace characters in the comment.
 	firstWord := ""
@@ -138,30 +284,92 @@ func checkCommentMismatch(
 		firstWord = words[0]
 	}
 
-	if firstWord == elementName {
+	if r.words.startsWithName(words, elementName, leadWords) {
+		if r.articles {
+			checkArticle(r, elementName, comment, words, leadWords)
+		}
+
 		return
 	}
 
-	// See if this comment has a first word that matches a lead word and then
-	// check if the second word matches the element name.
-	if len(words) > 1 {
-		secondWord := words[1]
+	// Replace the word after the lead word if there is one so the lead word is
+	// kept.
+	wrongWord := 0
+	if _, ok := leadWords[r.words.normalize(firstWord)]; ok && len(words) > 1 {
+		wrongWord = 1
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      comment.Pos(),
+		End:      comment.End(),
+		Category: ruleMismatch,
+		Message:  fmt.Sprintf(commentMismatchTmpl, firstWord, elementName),
+	}
 
-		if _, ok := leadWords[firstWord]; ok && secondWord == elementName {
-			return
+	if start, _, ok := commentWordPos(comment, wrongWord); ok {
+		// Only replace the part of the word compared to the name so backticks,
+		// punctuation, and possessives are kept.
+		core, offset := r.words.core(words[wrongWord])
+		start += token.Pos(offset)
+
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf(
+					replaceWordFixTmpl,
+					core,
+					elementName,
+				),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     start,
+						End:     start + token.Pos(len(core)),
+						NewText: []byte(elementName),
+					},
+				},
+			},
 		}
 	}
 
-	pass.Reportf(
-		comment.Pos(),
-		commentMismatchTmpl,
-		firstWord,
-		elementName,
-	)
+	r.report(elementName, diag)
+}
+
+// missingComment returns true if the element at elementPos has no comment and
+// no misplaced comment was reported for it.
+func missingComment(
+	r *reporter,
+	comment *ast.CommentGroup,
+	elementPos token.Pos,
+) bool {
+	// We only want to report missing comments if we haven't already reported the
+	// comment is empty.
+	if comment != nil &&
+		(len(comment.Text()) > 0 || !containsOnlyMachineReadableComment(comment)) {
+		return false
+	}
+
+	_, misplaced := r.misplaced[elementPos]
+
+	return !misplaced
+}
+
+// missingCommentMessage returns the message for a missing comment on the
+// element elementName, including the detail in r if there is one.
+func missingCommentMessage(r *reporter, elementName string) string {
+	visibility := exportedVisibility
+	if !ast.IsExported(elementName) {
+		visibility = unexportedVisibility
+	}
+
+	msg := fmt.Sprintf(commentMissingTmpl, visibility, elementName)
+	if len(r.missingDetail) > 0 {
+		msg += fmt.Sprintf(missingDetailTmpl, r.missingDetail)
+	}
+
+	return msg
 }
 
 func checkExported(
-	pass *analysis.Pass,
+	r *reporter,
 	commentExported bool,
 	commentAllExported bool,
 	elementName string,
@@ -170,157 +378,340 @@ func checkExported(
 	elementExported bool,
 	recvExported bool,
 ) {
-	commented := false
-	// We only want to report missing comments if we haven't already reported the
-	// comment is empty.
-	if comment != nil {
-		commented = len(comment.Text()) > 0 ||
-			!containsOnlyMachineReadableComment(comment)
-	}
-
-	if commented || !elementExported {
+	if !elementExported || !missingComment(r, comment, elementPos) {
 		return
 	}
 
 	// Either we're commenting everything or the receiver is exported and we're
 	// only commenting things with exported receivers and elements.
 	if commentAllExported || (recvExported && commentExported) {
-		pass.Reportf(
-			elementPos,
-			commentMissingTmpl,
-			elementName,
-		)
+		r.report(elementName, analysis.Diagnostic{
+			Pos:            elementPos,
+			Category:       ruleMissing,
+			Message:        missingCommentMessage(r, elementName),
+			SuggestedFixes: stubCommentFix(r, elementName, elementPos),
+		})
 	}
 }
 
-func isTestFunc(fset *token.FileSet, pos token.Pos, elementName string) bool {
-	fName := fset.Position(pos).Filename
-	if !strings.HasSuffix(fName, testFileNameSuffix) {
-		return false
+// stubCommentFix returns a fix that inserts a stub comment starting with
+// elementName on the line above the element at elementPos. The stub has the
+// same indentation as the element. No fix is returned if the element doesn't
+// start its line or the source file can't be read.
+func stubCommentFix(
+	r *reporter,
+	elementName string,
+	elementPos token.Pos,
+) []analysis.SuggestedFix {
+	f := r.pass.Fset.File(elementPos)
+	if f == nil {
+		return nil
 	}
 
-	for _, name := range testCommentPrefix {
-		if strings.HasPrefix(elementName, name) {
-			return true
-		}
+	src, ok := r.source(f)
+	if !ok {
+		return nil
 	}
 
-	return false
-}
+	offset := f.Offset(elementPos)
+	lineStart := f.Offset(f.LineStart(f.Line(elementPos)))
 
-func (m mimic) checkFuncDecl(pass *analysis.Pass, fun *ast.FuncDecl) {
-	// Default to true so free functions will be marked as needing a comment if
-	// commentExported is set.
-	exportedRecv := true
+	if offset > len(src) {
+		return nil
+	}
 
-	if fun.Recv != nil {
-		r := fun.Recv.List[0]
+	indent := src[lineStart:offset]
+	if len(bytes.TrimSpace(indent)) > 0 {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message: fmt.Sprintf(stubCommentFixTmpl, elementName),
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     elementPos,
+					End:     elementPos,
+					NewText: []byte(fmt.Sprintf(stubCommentTmpl, elementName, indent)),
+				},
+			},
+		},
+	}
+}
 
-		switch r.Type.(type) {
+// receiverIdent returns the identifier naming the type of a receiver or
+// embedded field with type expr. Pointers, parentheses, package names, and type
+// parameters of generic types are unwrapped to get to the identifier. Returns
+// nil if no identifier is found.
+func receiverIdent(expr ast.Expr) *ast.Ident {
+	for {
+		switch e := expr.(type) {
 		case *ast.Ident:
-			ident := r.Type.(*ast.Ident)
-			exportedRecv = ident.IsExported()
+			return e
+
+		case *ast.SelectorExpr:
+			return e.Sel
 
 		case *ast.StarExpr:
-			star := r.Type.(*ast.StarExpr)
+			expr = e.X
 
-			ident, ok := star.X.(*ast.Ident)
-			if !ok {
-				break
-			}
+		case *ast.ParenExpr:
+			expr = e.X
+
+		case *ast.IndexExpr:
+			expr = e.X
+
+		case *ast.IndexListExpr:
+			expr = e.X
+
+		default:
+			return nil
+		}
+	}
+}
+
+func (m mimic) checkFuncDecl(r *reporter, fun *ast.FuncDecl) {
+	// Default to true so free functions will be marked as needing a comment if
+	// commentExported is set.
+	exportedRecv := true
+	recvName := ""
+
+	if fun.Recv != nil {
+		recv := fun.Recv.List[0]
 
+		if ident := receiverIdent(recv.Type); ident != nil {
 			exportedRecv = ident.IsExported()
+			recvName = ident.Name
 		}
 	}
 
+	r.receiver = recvName
+	defer func() { r.receiver = "" }()
+
 	commentExported := m.commentExportedFuncs
 	commentAllExported := m.commentAllExportedFuncs
+	elementExported := fun.Name.IsExported()
+
+	isTest := m.tests.isTestFunc(r.pass.TypesInfo, r.pass.Fset, fun)
 
-	if !m.commentTests && isTestFunc(pass.Fset, fun.Pos(), fun.Name.Name) {
+	switch {
+	case isTest && !m.commentTests,
+		!isTest && m.skipTestHelpers &&
+			m.tests.isTestFile(r.pass.Fset.Position(fun.Pos()).Filename):
 		commentExported = false
 		commentAllExported = false
+
+	default:
+		// Long or complex functions need a comment whether they're exported or
+		// not. The finding says which threshold the function exceeds.
+		if exceeded := m.exceededThreshold(r.pass.Fset, fun); len(exceeded) > 0 {
+			commentAllExported = true
+			elementExported = true
+			r.missingDetail = exceeded
+
+			defer func() { r.missingDetail = "" }()
+		}
+	}
+
+	checkTrailingComment(
+		r,
+		fun.Name.Name,
+		fun.Pos(),
+		fun.Doc,
+		funcLineComment(r, fun),
+		m.leadWords.funcs,
+	)
+
+	if m.checkParamNames {
+		checkParamNames(r, fun.Name, fun.Doc, fun.Recv, fun.Type)
+	}
+
+	m.checkConventions(r, fun)
+
+	if m.qualifiedMethodNames &&
+		checkQualifiedName(r, recvName, fun.Name.Name, fun.Doc) {
+		return
 	}
 
 	checkComment(
-		pass,
+		r,
 		commentExported,
 		commentAllExported,
 		fun.Name.Name,
 		fun.Pos(),
 		fun.Doc,
-		fun.Name.IsExported(),
+		elementExported,
 		exportedRecv,
-		nil,
+		m.leadWords.funcs,
 	)
 }
 
-func (m mimic) checkGenDecl(pass *analysis.Pass, decl *ast.GenDecl) {
+// typeDoc returns the doc comment of the type declared by ts in decl and the
+// position to report missing comments at.
+//
+// If the type-declaration a single declaration (i.e. not grouped by
+// parentheses), then the doc comment is attached to the GenDecl node. If the
+// type is part of a grouped declaration, it's attached to the TypeSpec node.
+//
+// If typeBlockDoc is set and ts is the only spec in a grouped declaration, the
+// comment on the block is used if ts doesn't have a comment of its own, like
+// go/doc does. If both have a comment, the block comment is reported if it
+// doesn't start with the name of the type since it contradicts the comment on
+// the type.
+func (m mimic) typeDoc(
+	r *reporter,
+	decl *ast.GenDecl,
+	ts *ast.TypeSpec,
+	leadWords map[string]struct{},
+) (*ast.CommentGroup, token.Pos) {
+	if decl.Lparen == token.NoPos {
+		return decl.Doc, decl.Pos()
+	}
+
+	if !m.typeBlockDoc || len(decl.Specs) != 1 || decl.Doc == nil {
+		return ts.Doc, ts.Pos()
+	}
+
+	if ts.Doc == nil {
+		return decl.Doc, ts.Pos()
+	}
+
+	name := ts.Name.Name
+
+	if len(decl.Doc.Text()) > 0 &&
+		!r.words.startsWithName(commentWords(decl.Doc), name, leadWords) {
+		r.report(name, analysis.Diagnostic{
+			Pos:      decl.Doc.Pos(),
+			End:      decl.Doc.End(),
+			Category: ruleBlockConflict,
+			Message:  fmt.Sprintf(typeBlockConflictTmpl, name),
+		})
+	}
+
+	return ts.Doc, ts.Pos()
+}
+
+// missingCheckDoc returns the comment that counts towards the missing comment
+// check for the type ts with the comment doc. In go/doc mode types without a
+// comment of their own use the comment go/doc shows for them, which is the
+// comment on the grouped declaration. Same as const and var blocks, that
+// comment isn't checked for a matching first word since it describes all the
+// types in the block.
+func missingCheckDoc(
+	r *reporter,
+	ts *ast.TypeSpec,
+	doc *ast.CommentGroup,
+) *ast.CommentGroup {
+	if doc != nil {
+		return doc
+	}
+
+	if blockDoc, ok := r.docs.typeDoc(ts); ok {
+		return blockDoc
+	}
+
+	return nil
+}
+
+func (m mimic) checkGenDecl(r *reporter, decl *ast.GenDecl) {
 	for _, s := range decl.Specs {
 		ts, ok := s.(*ast.TypeSpec)
 		if !ok {
 			continue
 		}
 
-		var (
-			commentFlag bool
-			leadWords   map[string]struct{}
-		)
+		// Aliases are checked the same way no matter what they refer to.
+		if ts.Assign != token.NoPos {
+			leadWords := m.leadWords.forType(ts)
+			doc, pos := m.typeDoc(r, decl, ts, leadWords)
+			checkTrailingComment(
+				r,
+				ts.Name.Name,
+				pos,
+				missingCheckDoc(r, ts, doc),
+				ts.Comment,
+				leadWords,
+			)
+			m.checkAlias(r, ts, doc, pos)
+
+			continue
+		}
+
+		commentFlag := m.commentTypes
 
 		switch ts.Type.(type) {
 		case *ast.StructType:
 			commentFlag = m.commentStructs
-			leadWords = structLeadWords
 
 		case *ast.InterfaceType:
 			commentFlag = m.commentInterfaces
 
 		default:
-			continue
+			// Named types like func types, enums, maps, and slices are only checked
+			// if asked to.
+			if !commentFlag {
+				continue
+			}
 		}
 
+		leadWords := m.leadWords.forType(ts)
 		exportedRecv := ts.Name.IsExported()
-
-		// If the type-declaration a single declaration (i.e. not grouped by
-		// parentheses), then the doc comment is attached to the GenDecl node. If
-		// the interface is part of a grouped declaration, it's attached to the
-		// TypeSpec node.
-		doc := decl.Doc
-		pos := decl.Pos()
-
-		if decl.Lparen != token.NoPos {
-			doc = ts.Doc
-			pos = ts.Pos()
-		}
-
-		// Check if struct or interface is commented properly.
-		checkComment(
-			pass,
+		doc, pos := m.typeDoc(r, decl, ts, leadWords)
+		missingDoc := missingCheckDoc(r, ts, doc)
+		checkTrailingComment(r, ts.Name.Name, pos, missingDoc, ts.Comment, leadWords)
+
+		// Check if the type is commented properly.
+		checkCommentMismatch(r, ts.Name.Name, doc, pos, leadWords)
+		checkExported(
+			r,
 			// Set to false so the flag completely controls output behavior.
 			false,
 			commentFlag,
 			ts.Name.Name,
+			missingDoc,
 			pos,
-			doc,
 			exportedRecv,
 			true,
-			leadWords,
 		)
 
+		if st, ok := ts.Type.(*ast.StructType); ok {
+			m.checkStructFields(r, st, exportedRecv)
+			continue
+		}
+
 		iface, ok := ts.Type.(*ast.InterfaceType)
 		if !ok {
-			// Structs can't embed other type definitions or function names so it's
-			// safe to return after just checking the comment.
 			continue
 		}
 
+		r.receiver = ts.Name.Name
+
 		for _, field := range iface.Methods.List {
-			_, ok := field.Type.(*ast.FuncType)
+			ft, ok := field.Type.(*ast.FuncType)
 			if !ok {
 				continue
 			}
 
+			checkTrailingComment(
+				r,
+				field.Names[0].Name,
+				field.Pos(),
+				field.Doc,
+				field.Comment,
+				m.leadWords.funcs,
+			)
+
+			if m.checkParamNames {
+				checkParamNames(r, field.Names[0], field.Doc, nil, ft)
+			}
+
+			if m.qualifiedMethodNames &&
+				checkQualifiedName(r, ts.Name.Name, field.Names[0].Name, field.Doc) {
+				continue
+			}
+
 			checkComment(
-				pass,
+				r,
 				m.commentExportedFuncs,
 				m.commentAllExportedFuncs,
 				field.Names[0].Name,
@@ -328,13 +719,366 @@ func (m mimic) checkGenDecl(pass *analysis.Pass, decl *ast.GenDecl) {
 				field.Doc,
 				field.Names[0].IsExported(),
 				exportedRecv,
+				m.leadWords.funcs,
+			)
+		}
+
+		r.receiver = ""
+	}
+}
+
+// hasDeprecatedParagraph returns true if comment has a paragraph starting with
+// "Deprecated: ".
+func hasDeprecatedParagraph(comment *ast.CommentGroup) bool {
+	newParagraph := true
+
+	for _, line := range strings.Split(comment.Text(), "\n") {
+		line = strings.TrimSpace(line)
+
+		if newParagraph && strings.HasPrefix(line, deprecatedPrefix) {
+			return true
+		}
+
+		newParagraph = len(line) == 0
+	}
+
+	return false
+}
+
+// mentionsTarget returns true if text contains target as a whole word, so
+// "Mapping" doesn't mention "Map". Doc links like [http.Client] count.
+func mentionsTarget(text string, target string) bool {
+	for offset := 0; ; {
+		idx := strings.Index(text[offset:], target)
+		if idx < 0 {
+			return false
+		}
+
+		start := offset + idx
+		end := start + len(target)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+
+		if !isIdentRune(before) && !isIdentRune(after) {
+			return true
+		}
+
+		offset = start + 1
+	}
+}
+
+// checkAlias checks the comment on the type alias ts. Alias comments are
+// checked for a matching first word no matter what type the alias refers to.
+// If asked to, comments on exported aliases must also refer to the target type
+// or have a "Deprecated:" paragraph so readers know where to look instead.
+func (m mimic) checkAlias(
+	r *reporter,
+	ts *ast.TypeSpec,
+	doc *ast.CommentGroup,
+	pos token.Pos,
+) {
+	name := ts.Name.Name
+
+	checkCommentMismatch(r, name, doc, pos, m.leadWords.types)
+	checkExported(
+		r,
+		// Set to false so the flag completely controls output behavior.
+		false,
+		m.commentAliases,
+		name,
+		missingCheckDoc(r, ts, doc),
+		pos,
+		ts.Name.IsExported(),
+		true,
+	)
+
+	if !m.aliasDocTarget || !ts.Name.IsExported() || doc == nil ||
+		len(doc.Text()) == 0 {
+		return
+	}
+
+	// Type arguments are left out so comments can refer to generic types by
+	// name.
+	targetExpr := ts.Type
+
+	switch e := targetExpr.(type) {
+	case *ast.IndexExpr:
+		targetExpr = e.X
+
+	case *ast.IndexListExpr:
+		targetExpr = e.X
+	}
+
+	target := types.ExprString(targetExpr)
+
+	if mentionsTarget(doc.Text(), target) || hasDeprecatedParagraph(doc) {
+		return
+	}
+
+	r.report(name, analysis.Diagnostic{
+		Pos:      doc.Pos(),
+		End:      doc.End(),
+		Category: ruleAliasTarget,
+		Message:  fmt.Sprintf(aliasTargetTmpl, name, target),
+	})
+}
+
+// checkStructFields checks the comments on the fields of st. Trailing comments
+// used instead of a doc comment are reported if trailing comments are checked,
+// everything else is only checked if checking struct fields is enabled. Fields
+// of nested anonymous structs are checked as well, including ones behind
+// pointers, arrays, slices, and map values.
+//
+// Embedded fields are checked against the name of the embedded type but are
+// never required to have a comment since the embedded type is documented
+// already.
+func (m mimic) checkStructFields(
+	r *reporter,
+	st *ast.StructType,
+	structExported bool,
+) {
+	checkFields := m.commentStructFields || m.requireStructFieldComments
+
+	for _, field := range st.Fields.List {
+		var (
+			name     string
+			exported bool
+		)
+
+		if len(field.Names) > 0 {
+			name, exported = r.words.commentedName(field.Names, field.Doc)
+		} else if ident := receiverIdent(field.Type); ident != nil {
+			name = ident.Name
+		}
+
+		if len(name) > 0 {
+			checkTrailingComment(
+				r,
+				name,
+				field.Pos(),
+				field.Doc,
+				field.Comment,
 				nil,
 			)
 		}
+
+		if len(name) > 0 && checkFields {
+			checkCommentMismatch(r, name, field.Doc, field.Pos(), nil)
+			checkExported(
+				r,
+				// Set to false so the flag completely controls output behavior.
+				false,
+				m.requireStructFieldComments && structExported,
+				name,
+				field.Doc,
+				field.Pos(),
+				exported,
+				true,
+			)
+		}
+
+		if nested := nestedStruct(field.Type); nested != nil {
+			m.checkStructFields(r, nested, structExported && exported)
+		}
+	}
+}
+
+// nestedStruct returns the anonymous struct in the type of a field, looking
+// through pointers, arrays, slices, and map values. Returns nil if the type
+// doesn't contain an anonymous struct.
+func nestedStruct(expr ast.Expr) *ast.StructType {
+	for {
+		switch e := expr.(type) {
+		case *ast.StructType:
+			return e
+
+		case *ast.StarExpr:
+			expr = e.X
+
+		case *ast.ParenExpr:
+			expr = e.X
+
+		case *ast.ArrayType:
+			expr = e.Elt
+
+		case *ast.MapType:
+			expr = e.Value
+
+		default:
+			return nil
+		}
+	}
+}
+
+// commentedName returns the name comment should be checked against and whether
+// any of names are exported. Value specs and struct fields can declare several
+// names at once so the comment may start with any of them. If the comment
+// doesn't start with any of the names the first exported name, or the first
+// name if none are exported, is returned. An empty name is returned if all
+// names are blank identifiers.
+func (wn wordNormalizer) commentedName(
+	names []*ast.Ident,
+	comment *ast.CommentGroup,
+) (string, bool) {
+	var (
+		firstWord string
+		name      string
+		exported  bool
+	)
+
+	if comment != nil {
+		if words := commentWords(comment); len(words) > 0 {
+			firstWord = wn.normalize(words[0])
+		}
+	}
+
+	for _, ident := range names {
+		if ident.Name == "_" {
+			continue
+		}
+
+		exported = exported || ident.IsExported()
+
+		// Prefer the name the comment starts with, then the first exported name,
+		// then the first name.
+		switch {
+		case len(name) > 0 && name == firstWord:
+		case ident.Name == firstWord,
+			len(name) == 0,
+			ident.IsExported() && !ast.IsExported(name):
+			name = ident.Name
+		}
+	}
+
+	return name, exported
+}
+
+func (m mimic) checkValueDecl(r *reporter, decl *ast.GenDecl) {
+	commentFlag := m.commentConsts
+	if decl.Tok == token.VAR {
+		commentFlag = m.commentVars
+	}
+
+	// Comments on consts and vars vary a lot in format so don't look at them at
+	// all unless asked to.
+	if !commentFlag && !m.checkValueFirstWord {
+		return
+	}
+
+	for _, s := range decl.Specs {
+		vs, ok := s.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		// Same as type declarations, the doc comment is attached to the GenDecl
+		// node unless the declaration is grouped by parentheses.
+		doc := decl.Doc
+		pos := decl.Pos()
+
+		if decl.Lparen != token.NoPos {
+			doc = vs.Doc
+			pos = vs.Pos()
+		}
+
+		name, exported := r.words.commentedName(vs.Names, doc)
+		if len(name) == 0 {
+			continue
+		}
+
+		if m.checkValueFirstWord {
+			checkCommentMismatch(r, name, doc, pos, nil)
+		} else if doc != nil {
+			checkCommentEmpty(r, name, doc, pos)
+		}
+
+		// The comment on the block only counts towards the missing comment check.
+		// It's not checked for a matching first word since it's describing all the
+		// specs in the block. go/doc always shows the comment on the block so it
+		// counts in go/doc mode too.
+		if doc == nil {
+			if blockDoc, ok := r.docs.valueDoc(decl); ok {
+				doc = blockDoc
+			} else if m.valueBlockDoc {
+				doc = decl.Doc
+			}
+		}
+
+		checkExported(
+			r,
+			// Set to false so the flag completely controls output behavior.
+			false,
+			commentFlag,
+			name,
+			doc,
+			pos,
+			exported,
+			true,
+		)
 	}
 }
 
 func (m mimic) run(pass *analysis.Pass) (any, error) {
+	if len(m.configFile) > 0 {
+		cfg, err := m.config.load(m.configFile)
+		if err != nil {
+			return nil, err
+		}
+
+		// m is a copy so changes only apply to this package.
+		if err := cfg.apply(pass, &m); err != nil {
+			return nil, err
+		}
+
+		// Flags given on the command line take precedence over the config file.
+		given, err := m.given.options()
+		if err != nil {
+			return nil, err
+		}
+
+		given.apply(&m)
+	}
+
+	if err := m.tests.validate(); err != nil {
+		return nil, err
+	}
+
+	base, err := m.baseline.forPass(pass)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &reporter{
+		pass:      pass,
+		ignores:   newIgnoreDirectives(pass),
+		baseline:  base,
+		comments:  fileComments(pass),
+		misplaced: map[token.Pos]struct{}{},
+		sources:   map[*token.File][]byte{},
+		words: wordNormalizer{
+			backticks:   m.stripBackticks,
+			punctuation: m.stripPunctuation,
+			possessive:  m.stripPossessive,
+		},
+		articles: m.checkArticles,
+		trailing: m.checkTrailingComments,
+	}
+
+	if m.goDoc {
+		if r.docs, err = newGoDocs(pass); err != nil {
+			return nil, err
+		}
+	}
+
+	checkPackageDoc(r, m.requirePackageComment)
+
+	findOrphanedComments(r, m.leadWords)
+
+	if m.checkDocLinks {
+		checkDocLinks(r)
+	}
+
 	inspec := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 
 	nodeFilter := []ast.Node{
@@ -345,40 +1089,128 @@ func (m mimic) run(pass *analysis.Pass) (any, error) {
 	inspec.Nodes(nodeFilter, func(node ast.Node, push bool) bool {
 		switch switched := node.(type) {
 		case *ast.FuncDecl:
-			m.checkFuncDecl(pass, switched)
+			m.checkFuncDecl(r, switched)
 
 		case *ast.GenDecl:
-			if switched.Tok != token.TYPE {
-				break
-			}
+			switch switched.Tok {
+			case token.TYPE:
+				m.checkGenDecl(r, switched)
 
-			m.checkGenDecl(pass, switched)
+			case token.CONST, token.VAR:
+				m.checkValueDecl(r, switched)
+			}
 		}
 
 		return false
 	})
 
-	return nil, nil
+	r.ignores.report(pass, m.requireIgnoreReason)
+
+	return nil, base.finish()
 }
 
 const (
-	CommentExportedFuncsFlag    = "comment-exported"
-	CommentAllExportedFuncsFlag = "comment-all-exported"
-	CommentInterfacesFlag       = "comment-interfaces"
-	CommentTestsFlag            = "comment-tests"
-	CommentStructsFlag          = "comment-structs"
+	CommentExportedFuncsFlag       = "comment-exported"
+	CommentAllExportedFuncsFlag    = "comment-all-exported"
+	CommentInterfacesFlag          = "comment-interfaces"
+	CommentTestsFlag               = "comment-tests"
+	CommentStructsFlag             = "comment-structs"
+	CommentConstsFlag              = "comment-consts"
+	CommentVarsFlag                = "comment-vars"
+	CheckValueFirstWordFlag        = "check-value-first-word"
+	ValueBlockDocFlag              = "value-block-doc"
+	ConfigFlag                     = "config"
+	RequireIgnoreReasonFlag        = "require-ignore-reason"
+	BaselineFlag                   = "baseline"
+	WriteBaselineFlag              = "write-baseline"
+	RequirePackageCommentFlag      = "require-package-comment"
+	CommentTypesFlag               = "comment-types"
+	CommentAliasesFlag             = "comment-aliases"
+	AliasDocTargetFlag             = "alias-doc-target"
+	TypeBlockDocFlag               = "type-block-doc"
+	GoDocFlag                      = "go-doc"
+	CommentStructFieldsFlag        = "comment-struct-fields"
+	RequireStructFieldCommentsFlag = "require-struct-field-comments"
+	StripBackticksFlag             = "strip-backticks"
+	StripPunctuationFlag           = "strip-punctuation"
+	StripPossessiveFlag            = "strip-possessive"
+	QualifiedMethodNamesFlag       = "qualified-method-names"
+	StructLeadWordsFlag            = "struct-lead-words"
+	InterfaceLeadWordsFlag         = "interface-lead-words"
+	TypeLeadWordsFlag              = "type-lead-words"
+	FuncLeadWordsFlag              = "func-lead-words"
+	CheckArticlesFlag              = "check-articles"
+	CheckTrailingCommentsFlag      = "check-trailing-comments"
+	CheckDocLinksFlag              = "check-doc-links"
+	CheckParamNamesFlag            = "check-param-names"
+	CheckBoolVerbFlag              = "check-bool-verb"
+	CheckConstructorDocFlag        = "check-constructor-doc"
+	CheckErrorDocFlag              = "check-error-doc"
+	CommentLongFuncsFlag           = "comment-long-funcs"
+	CommentComplexFuncsFlag        = "comment-complex-funcs"
+	TestPrefixesFlag               = "test-prefixes"
+	TestFilePatternsFlag           = "test-file-patterns"
+	SkipTestHelpersFlag            = "skip-test-helpers"
 )
 
 type mimic struct {
-	commentExportedFuncs    bool
-	commentAllExportedFuncs bool
-	commentInterfaces       bool
-	commentStructs          bool
-	commentTests            bool
+	commentExportedFuncs       bool
+	commentAllExportedFuncs    bool
+	commentInterfaces          bool
+	commentStructs             bool
+	commentTests               bool
+	commentConsts              bool
+	commentVars                bool
+	checkValueFirstWord        bool
+	valueBlockDoc              bool
+	requireIgnoreReason        bool
+	requirePackageComment      bool
+	commentTypes               bool
+	commentAliases             bool
+	aliasDocTarget             bool
+	typeBlockDoc               bool
+	goDoc                      bool
+	commentStructFields        bool
+	requireStructFieldComments bool
+	stripBackticks             bool
+	stripPunctuation           bool
+	stripPossessive            bool
+	qualifiedMethodNames       bool
+	checkArticles              bool
+	checkTrailingComments      bool
+	checkDocLinks              bool
+	checkParamNames            bool
+	checkBoolVerb              bool
+	checkConstructorDoc        bool
+	checkErrorDoc              bool
+	commentLongFuncs           int
+	commentComplexFuncs        int
+	skipTestHelpers            bool
+	configFile                 string
+	leadWords                  leadWordSets
+	tests                      testMatcher
+
+	config   *configLoader
+	baseline *baseline
+	given    givenFlags
 }
 
 func New() *analysis.Analyzer {
-	m := mimic{}
+	m := mimic{
+		config:   &configLoader{},
+		baseline: &baseline{},
+		given:    givenFlags{},
+		leadWords: leadWordSets{
+			structs:    newWordSet(defaultTypeLeadWords),
+			interfaces: wordSet{},
+			types:      newWordSet(defaultTypeLeadWords),
+			funcs:      wordSet{},
+		},
+		tests: testMatcher{
+			prefixes:     wordSet{},
+			filePatterns: newWordSet(defaultTestFilePatterns),
+		},
+	}
 
 	fs := flag.NewFlagSet("CommentMimicFlags", flag.ExitOnError)
 	fs.BoolVar(
@@ -416,6 +1248,256 @@ func New() *analysis.Analyzer {
 		"require comments on all exported structs",
 	)
 
+	fs.BoolVar(
+		&m.commentConsts,
+		CommentConstsFlag,
+		false,
+		"require comments on all exported consts",
+	)
+
+	fs.BoolVar(
+		&m.commentVars,
+		CommentVarsFlag,
+		false,
+		"require comments on all exported vars",
+	)
+
+	fs.BoolVar(
+		&m.checkValueFirstWord,
+		CheckValueFirstWordFlag,
+		false,
+		"check the first word of const and var comments matches the declared name",
+	)
+
+	fs.BoolVar(
+		&m.valueBlockDoc,
+		ValueBlockDocFlag,
+		false,
+		"comments on const and var blocks count for specs without their own comment",
+	)
+
+	fs.BoolVar(
+		&m.requireIgnoreReason,
+		RequireIgnoreReasonFlag,
+		false,
+		"require a reason on commentmimic:ignore directives",
+	)
+
+	fs.BoolVar(
+		&m.requirePackageComment,
+		RequirePackageCommentFlag,
+		false,
+		"require a package comment on all packages except main",
+	)
+
+	fs.BoolVar(
+		&m.commentTypes,
+		CommentTypesFlag,
+		false,
+		"check and require comments on other named types like func types or enums",
+	)
+
+	fs.BoolVar(
+		&m.commentAliases,
+		CommentAliasesFlag,
+		false,
+		"require comments on all exported type aliases",
+	)
+
+	fs.BoolVar(
+		&m.aliasDocTarget,
+		AliasDocTargetFlag,
+		false,
+		"comments on exported aliases must refer to the target or be deprecated",
+	)
+
+	fs.BoolVar(
+		&m.typeBlockDoc,
+		TypeBlockDocFlag,
+		false,
+		"comments on type blocks with a single type count as the type's comment",
+	)
+
+	fs.BoolVar(
+		&m.goDoc,
+		GoDocFlag,
+		false,
+		"check comments the way go/doc associates them with elements",
+	)
+
+	fs.BoolVar(
+		&m.commentStructFields,
+		CommentStructFieldsFlag,
+		false,
+		"check the first word of struct field comments matches the field name",
+	)
+
+	fs.BoolVar(
+		&m.requireStructFieldComments,
+		RequireStructFieldCommentsFlag,
+		false,
+		"require comments on exported fields of exported structs",
+	)
+
+	fs.BoolVar(
+		&m.stripBackticks,
+		StripBackticksFlag,
+		false,
+		"ignore backticks around the words compared to element names",
+	)
+
+	fs.BoolVar(
+		&m.stripPunctuation,
+		StripPunctuationFlag,
+		false,
+		"ignore trailing commas, colons, and periods on words compared to names",
+	)
+
+	fs.BoolVar(
+		&m.stripPossessive,
+		StripPossessiveFlag,
+		false,
+		"ignore a trailing 's on words compared to element names",
+	)
+
+	fs.BoolVar(
+		&m.qualifiedMethodNames,
+		QualifiedMethodNamesFlag,
+		false,
+		"allow method comments to start with T.Method or (*T).Method",
+	)
+
+	fs.Var(
+		&m.leadWords.structs,
+		StructLeadWordsFlag,
+		"comma separated words allowed before the name in struct comments",
+	)
+
+	fs.Var(
+		&m.leadWords.interfaces,
+		InterfaceLeadWordsFlag,
+		"comma separated words allowed before the name in interface comments",
+	)
+
+	fs.Var(
+		&m.leadWords.types,
+		TypeLeadWordsFlag,
+		"comma separated words allowed before the name in other type comments",
+	)
+
+	fs.Var(
+		&m.leadWords.funcs,
+		FuncLeadWordsFlag,
+		"comma separated words allowed before the name in function comments",
+	)
+
+	fs.BoolVar(
+		&m.checkArticles,
+		CheckArticlesFlag,
+		false,
+		"check 'A' and 'An' lead words agree with the sound the name starts with",
+	)
+
+	fs.BoolVar(
+		&m.checkTrailingComments,
+		CheckTrailingCommentsFlag,
+		false,
+		"report comments at the end of an element's line meant as its doc comment",
+	)
+
+	fs.BoolVar(
+		&m.checkDocLinks,
+		CheckDocLinksFlag,
+		false,
+		"report doc links in comments that don't refer to existing identifiers",
+	)
+
+	fs.BoolVar(
+		&m.checkParamNames,
+		CheckParamNamesFlag,
+		false,
+		"report parameter names in function comments that aren't in the signature",
+	)
+
+	fs.BoolVar(
+		&m.checkBoolVerb,
+		CheckBoolVerbFlag,
+		false,
+		"require comments on functions returning a bool to say 'reports whether'",
+	)
+
+	fs.BoolVar(
+		&m.checkConstructorDoc,
+		CheckConstructorDocFlag,
+		false,
+		"require comments on New functions to mention the type they return",
+	)
+
+	fs.BoolVar(
+		&m.checkErrorDoc,
+		CheckErrorDocFlag,
+		false,
+		"require comments on functions returning an error to describe failures",
+	)
+
+	fs.IntVar(
+		&m.commentLongFuncs,
+		CommentLongFuncsFlag,
+		0,
+		"require comments on functions with more than `N` lines in their body",
+	)
+
+	fs.IntVar(
+		&m.commentComplexFuncs,
+		CommentComplexFuncsFlag,
+		0,
+		"require comments on functions with a cyclomatic complexity above `N`",
+	)
+
+	fs.Var(
+		&m.tests.prefixes,
+		TestPrefixesFlag,
+		"comma separated prefixes of test functions, like Spec or Describe, "+
+			"added to Test, Benchmark, Fuzz, and Example which can't be removed",
+	)
+
+	fs.Var(
+		&m.tests.filePatterns,
+		TestFilePatternsFlag,
+		"comma separated glob patterns for the base names of test files; "+
+			"package comments and go/doc mode only skip _test.go files since "+
+			"go doc shows the comments in all other files",
+	)
+
+	fs.BoolVar(
+		&m.skipTestHelpers,
+		SkipTestHelpersFlag,
+		false,
+		"don't require comments on functions in test files that aren't tests",
+	)
+
+	fs.StringVar(
+		&m.configFile,
+		ConfigFlag,
+		"",
+		"YAML or JSON config file with options and per-package overrides",
+	)
+
+	fs.StringVar(
+		&m.baseline.path,
+		BaselineFlag,
+		"",
+		"baseline file with findings that shouldn't be reported",
+	)
+
+	fs.Var(
+		baselineWriteFlag{b: m.baseline},
+		WriteBaselineFlag,
+		"write all findings to the given baseline file instead of reporting them",
+	)
+
+	m.given.track(fs)
+
 	return &analysis.Analyzer{
 		Name: "commentmimic",
 		//nolint:lll
@@ -3,6 +3,8 @@ package commentmimic_test
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
 
 	"github.com/ashmrtn/commentmimic/pkg/commentmimic"
@@ -423,6 +426,48 @@ func genFunctionCasesWithExports(
 	return res
 }
 
+// genGenericFunctionCases takes a set of partially populated test cases and
+// returns a set of fully populated test cases. For each generated case, the
+// receiver has the export status given by receiverExported. The generated tests
+// cover:
+//   - generic receiver functions
+//   - generic pointer receiver functions
+//   - generic receiver functions with several type parameters
+//   - generic pointer receiver functions with several type parameters
+//   - parenthesized receiver functions
+//   - parenthesized pointer receiver functions
+func genGenericFunctionCases(
+	tests []templateData,
+	recvName string,
+	receiverExported bool,
+) map[string][]templateData {
+	res := map[string][]templateData{}
+	templates := []string{
+		"GenericReceiverFunction",
+		"GenericReceiverPtrFunction",
+		"GenericListReceiverFunction",
+		"GenericListReceiverPtrFunction",
+		"ParenReceiverFunction",
+		"ParenReceiverPtrFunction",
+	}
+
+	for _, tmplName := range templates {
+		fullName := "Unexported" + tmplName
+		if receiverExported {
+			fullName = "Exported" + tmplName
+		}
+
+		for _, testCase := range tests {
+			testCase.template = tmplName
+			testCase.Receiver = recvName
+
+			res[fullName] = append(res[fullName], testCase)
+		}
+	}
+
+	return res
+}
+
 func genInterfaceFuncCases(
 	tests []templateData,
 	interfaceName string,
@@ -584,17 +629,22 @@ func genTestFiles(
 		"a/a.go": fullFile,
 	}
 
+	return writeTestFiles(t, fileMap)
+}
+
+func writeTestFiles(t *testing.T, fileMap map[string]string) (string, func()) {
+	t.Helper()
+
 	dir, cleanup, err := analysistest.WriteFiles(fileMap)
 	require.NoError(t, err)
 
 	return dir, cleanup
 }
 
-func executeMimicWithFlagsOnFiles(
+func newMimicWithFlags(
 	t *testing.T,
 	flags map[string]bool,
-	dir string,
-) {
+) *analysis.Analyzer {
 	t.Helper()
 
 	mimic := commentmimic.New()
@@ -603,7 +653,42 @@ func executeMimicWithFlagsOnFiles(
 		require.NoError(t, mimic.Flags.Set(flag, strconv.FormatBool(value)))
 	}
 
-	analysistest.Run(t, dir, mimic, "a")
+	return mimic
+}
+
+func newMimicWithFlagValues(
+	t *testing.T,
+	flags map[string]string,
+) *analysis.Analyzer {
+	t.Helper()
+
+	mimic := commentmimic.New()
+
+	for flag, value := range flags {
+		require.NoError(t, mimic.Flags.Set(flag, value))
+	}
+
+	return mimic
+}
+
+func executeMimicWithFlagsOnFiles(
+	t *testing.T,
+	flags map[string]bool,
+	dir string,
+) {
+	t.Helper()
+
+	analysistest.Run(t, dir, newMimicWithFlags(t, flags), "a")
+}
+
+func executeMimicWithFixesOnFiles(
+	t *testing.T,
+	flags map[string]bool,
+	dir string,
+) {
+	t.Helper()
+
+	analysistest.RunWithSuggestedFixes(t, dir, newMimicWithFlags(t, flags), "a")
 }
 
 func executeCommentMimicWithAllFlagCombos(
@@ -649,29 +734,27 @@ func TestCommentMimic(t *testing.T) {
 	suite.Run(t, new(CommentMimicSuite))
 }
 
-func (s *CommentMimicSuite) TestDoesNotErrorOnOutOfScope() {
-	for _, flags := range flagProduct {
-		flags := flags
+func (s *CommentMimicSuite) TestValueFirstWordWithAllFlags() {
+	for _, product := range flagProduct {
+		flags := map[string]bool{
+			commentmimic.CheckValueFirstWordFlag: true,
+		}
+
+		for flag, value := range product {
+			flags[flag] = value
+		}
 
 		s.T().Run(flagsToTestName(flags), func(t *testing.T) {
 			t.Parallel()
 
 			fileMap := map[string]string{
-				"a/a.go": testdata.OutOfScopePatterns,
+				"a/a.go": testdata.ValueFirstWord,
 			}
 
-			dir, cleanup, err := analysistest.WriteFiles(fileMap)
-			require.NoError(t, err)
-
+			dir, cleanup := writeTestFiles(t, fileMap)
 			defer cleanup()
 
-			mimic := commentmimic.New()
-
-			for flag, value := range flags {
-				require.NoError(s.T(), mimic.Flags.Set(flag, strconv.FormatBool(value)))
-			}
-
-			analysistest.Run(t, dir, mimic, "a")
+			executeMimicWithFlagsOnFiles(t, flags, dir)
 		})
 	}
 }
@@ -1610,3 +1693,888 @@ func (s *CommentMimicSuite) TestCommentExportedStructs() {
 		})
 	}
 }
+
+func (s *CommentMimicSuite) TestValueComments() {
+	table := []struct {
+		name  string
+		input string
+		flags map[string]bool
+	}{
+		{
+			name:  "MissingComments",
+			input: testdata.ValueMissingComments,
+			flags: map[string]bool{
+				commentmimic.CommentConstsFlag: true,
+				commentmimic.CommentVarsFlag:   true,
+			},
+		},
+		{
+			name:  "BlockDoc",
+			input: testdata.ValueBlockDoc,
+			flags: map[string]bool{
+				commentmimic.CommentConstsFlag:       true,
+				commentmimic.CommentVarsFlag:         true,
+				commentmimic.CheckValueFirstWordFlag: true,
+				commentmimic.ValueBlockDocFlag:       true,
+			},
+		},
+	}
+
+	for _, test := range table {
+		test := test
+
+		s.T().Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fileMap := map[string]string{
+				"a/a.go": test.input,
+			}
+
+			dir, cleanup := writeTestFiles(t, fileMap)
+			defer cleanup()
+
+			executeMimicWithFlagsOnFiles(t, test.flags, dir)
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestMismatchSuggestedFixes() {
+	t := s.T()
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.MismatchFixes,
+		"a/a.go.golden": testdata.MismatchFixesGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, nil, dir)
+}
+
+func (s *CommentMimicSuite) TestStubCommentSuggestedFixes() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.CommentInterfacesFlag:       true,
+		commentmimic.CommentStructsFlag:          true,
+		commentmimic.CommentConstsFlag:           true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.StubCommentFixes,
+		"a/a.go.golden": testdata.StubCommentFixesGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestCommentAccessibleExportedGenericFuncs() {
+	const (
+		element  = "element"
+		receiver = "receiver"
+	)
+
+	var (
+		flags = map[string]bool{
+			commentmimic.CommentExportedFuncsFlag:    true,
+			commentmimic.CommentAllExportedFuncsFlag: false,
+			commentmimic.CommentInterfacesFlag:       false,
+			commentmimic.CommentStructsFlag:          false,
+		}
+
+		exportedRecvCases = []templateData{
+			{
+				name: "NoError",
+				FirstWord: commentData{
+					Type: testdata.InlineComment,
+					Text: capWord(element),
+				},
+				Element: capWord(element),
+			},
+			{
+				name: "MimicError",
+				FirstWord: commentData{
+					Type: testdata.InlineComment,
+					Text: "foo",
+				},
+				CommentError: true,
+				Element:      capWord(element),
+			},
+			{
+				name:         "Error",
+				Element:      capWord(element),
+				ElementError: true,
+			},
+			{
+				name:    "UnexportedFuncNoError",
+				Element: lowerWord(element),
+			},
+		}
+
+		unexportedRecvCases = []templateData{
+			{
+				name: "MimicError",
+				FirstWord: commentData{
+					Type: testdata.InlineComment,
+					Text: "foo",
+				},
+				CommentError: true,
+				Element:      capWord(element),
+			},
+			{
+				name:    "NoError",
+				Element: capWord(element),
+			},
+		}
+	)
+
+	cases := genGenericFunctionCases(
+		exportedRecvCases,
+		capWord(receiver),
+		exported,
+	)
+
+	for k, v := range genGenericFunctionCases(
+		unexportedRecvCases,
+		lowerWord(receiver),
+		unexported,
+	) {
+		cases[k] = append(cases[k], v...)
+	}
+
+	for name, caseList := range cases {
+		name := name
+		caseList := caseList
+
+		s.T().Run(name, func(t1 *testing.T) {
+			t1.Parallel()
+
+			for _, test := range caseList {
+				test := test
+
+				t1.Run(test.name, func(t *testing.T) {
+					t.Parallel()
+					executeCommentMimic(
+						t,
+						fileTmpl,
+						test.template,
+						test,
+						flags,
+					)
+				})
+			}
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestConfigFile() {
+	table := []struct {
+		name   string
+		file   string
+		config string
+	}{
+		{
+			name:   "YAML",
+			file:   ".commentmimic.yml",
+			config: testdata.ConfigYAML,
+		},
+		{
+			name:   "JSON",
+			file:   ".commentmimic.json",
+			config: testdata.ConfigJSON,
+		},
+	}
+
+	for _, test := range table {
+		test := test
+
+		s.T().Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fileMap := map[string]string{
+				test.file:             test.config,
+				"a/a.go":              testdata.ConfigTopLevel,
+				"a/internal/b/b.go":   testdata.ConfigOverride,
+				"a/internal/c/c.go":   testdata.ConfigNestedOverride,
+				"a/internal/d/e/e.go": testdata.ConfigParentOverride,
+				"b/b.go":              testdata.ConfigNoOverride,
+			}
+
+			dir, cleanup := writeTestFiles(t, fileMap)
+			defer cleanup()
+
+			mimic := newMimicWithFlagValues(t, map[string]string{
+				commentmimic.ConfigFlag: filepath.Join(dir, "src", test.file),
+			})
+
+			analysistest.Run(t, dir, mimic, "a/...", "b")
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestConfigFileFlagPrecedence() {
+	t := s.T()
+
+	fileMap := map[string]string{
+		".commentmimic.yml": testdata.ConfigFlagPrecedenceYAML,
+		"a/a.go":            testdata.ConfigFlagPrecedence,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	configFile := filepath.Join(dir, "src", ".commentmimic.yml")
+
+	mimic := newMimicWithFlagValues(t, map[string]string{
+		commentmimic.ConfigFlag:                  configFile,
+		commentmimic.CommentAllExportedFuncsFlag: "true",
+		commentmimic.CommentStructsFlag:          "false",
+	})
+
+	analysistest.Run(t, dir, mimic, "a")
+}
+
+func (s *CommentMimicSuite) TestIgnoreDirectives() {
+	table := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Element",
+			input: testdata.IgnoreDirectives,
+		},
+		{
+			name:  "File",
+			input: testdata.IgnoreFileDirective,
+		},
+		{
+			name:  "FileUnused",
+			input: testdata.IgnoreFileDirectiveUnused,
+		},
+	}
+
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.RequireIgnoreReasonFlag:     true,
+	}
+
+	for _, test := range table {
+		test := test
+
+		s.T().Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fileMap := map[string]string{
+				"a/a.go": test.input,
+			}
+
+			dir, cleanup := writeTestFiles(t, fileMap)
+			defer cleanup()
+
+			executeMimicWithFlagsOnFiles(t, flags, dir)
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestBaseline() {
+	t := s.T()
+	baselineFile := filepath.Join(t.TempDir(), "baseline.json")
+
+	runWithFlags := func(
+		t *testing.T,
+		input string,
+		flags map[string]string,
+	) *analysis.Analyzer {
+		t.Helper()
+
+		fileMap := map[string]string{
+			"a/a.go": input,
+		}
+
+		dir, cleanup := writeTestFiles(t, fileMap)
+		defer cleanup()
+
+		mimic := newMimicWithFlagValues(t, flags)
+		analysistest.Run(t, dir, mimic, "a")
+
+		return mimic
+	}
+
+	// Findings are recorded instead of reported when writing a baseline.
+	mimic := runWithFlags(
+		t,
+		testdata.BaselineLegacy,
+		map[string]string{
+			commentmimic.CommentAllExportedFuncsFlag: "true",
+			commentmimic.WriteBaselineFlag:           baselineFile,
+		},
+	)
+
+	// The baseline is only written once all packages are analyzed.
+	require.NoFileExists(t, baselineFile)
+	require.NoError(t, commentmimic.WriteBaseline(mimic))
+
+	data, err := os.ReadFile(baselineFile)
+	require.NoError(t, err)
+	require.JSONEq(t, testdata.BaselineLegacyEntries, string(data))
+
+	runWithFlags(
+		t,
+		testdata.BaselineUpdated,
+		map[string]string{
+			commentmimic.CommentAllExportedFuncsFlag: "true",
+			commentmimic.BaselineFlag:                baselineFile,
+		},
+	)
+}
+
+func (s *CommentMimicSuite) TestPackageComments() {
+	table := []struct {
+		name    string
+		files   map[string]string
+		require bool
+	}{
+		{
+			name: "Correct",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocCorrect,
+			},
+			require: true,
+		},
+		{
+			name: "Mismatch",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocMismatch,
+			},
+		},
+		{
+			name: "WrongName",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocWrongName,
+			},
+		},
+		{
+			name: "Empty",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocEmpty,
+			},
+		},
+		{
+			name: "Missing",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocMissing,
+			},
+			require: true,
+		},
+		{
+			name: "MissingNotRequired",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocOtherFile,
+			},
+		},
+		{
+			name: "OtherFile",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocOtherFile,
+				"a/b.go": testdata.PackageDocCorrect,
+			},
+			require: true,
+		},
+		{
+			name: "Duplicate",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocCorrect,
+				"a/b.go": testdata.PackageDocDuplicate,
+			},
+		},
+		{
+			name: "Conflict",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocCorrect,
+				"a/b.go": testdata.PackageDocConflict,
+			},
+		},
+		{
+			name: "DirectiveAndDoc",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocDirective,
+				"a/b.go": testdata.PackageDocDuplicate,
+			},
+			require: true,
+		},
+		{
+			name: "DirectiveOnly",
+			files: map[string]string{
+				"a/a.go": testdata.PackageDocDirectiveMissing,
+			},
+			require: true,
+		},
+		{
+			name: "TestFile",
+			files: map[string]string{
+				"a/a.go":      testdata.PackageDocCorrect,
+				"a/a_test.go": testdata.PackageDocTestFile,
+			},
+		},
+		{
+			name: "CommandCorrect",
+			files: map[string]string{
+				"a/a.go": testdata.CommandDocCorrect,
+			},
+			require: true,
+		},
+		{
+			name: "CommandName",
+			files: map[string]string{
+				"a/a.go": testdata.CommandDocName,
+			},
+		},
+		{
+			name: "CommandMismatch",
+			files: map[string]string{
+				"a/a.go": testdata.CommandDocMismatch,
+			},
+		},
+		{
+			name: "CommandMissing",
+			files: map[string]string{
+				"a/a.go": testdata.CommandDocMissing,
+			},
+			require: true,
+		},
+	}
+
+	for _, test := range table {
+		test := test
+
+		s.T().Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir, cleanup := writeTestFiles(t, test.files)
+			defer cleanup()
+
+			flags := map[string]bool{
+				commentmimic.RequirePackageCommentFlag: test.require,
+			}
+
+			executeMimicWithFlagsOnFiles(t, flags, dir)
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestStructFieldComments() {
+	table := []struct {
+		name  string
+		input string
+		flags map[string]bool
+	}{
+		{
+			name:  "Required",
+			input: testdata.StructFieldComments,
+			flags: map[string]bool{
+				commentmimic.RequireStructFieldCommentsFlag: true,
+			},
+		},
+		{
+			name:  "NotRequired",
+			input: testdata.StructFieldCommentsNotRequired,
+			flags: map[string]bool{
+				commentmimic.CommentStructFieldsFlag: true,
+			},
+		},
+	}
+
+	for _, test := range table {
+		test := test
+
+		s.T().Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fileMap := map[string]string{
+				"a/a.go": test.input,
+			}
+
+			dir, cleanup := writeTestFiles(t, fileMap)
+			defer cleanup()
+
+			executeMimicWithFlagsOnFiles(t, test.flags, dir)
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestNamedTypeComments() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentTypesFlag: true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go": testdata.NamedTypes,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFlagsOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestAliasComments() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAliasesFlag: true,
+		commentmimic.AliasDocTargetFlag: true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go": testdata.Aliases,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFlagsOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestTypeBlockComments() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentStructsFlag: true,
+		commentmimic.TypeBlockDocFlag:   true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go": testdata.TypeBlockDoc,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFlagsOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestGoDocMode() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.CommentStructsFlag:          true,
+		commentmimic.CommentInterfacesFlag:       true,
+		commentmimic.CommentConstsFlag:           true,
+		commentmimic.GoDocFlag:                   true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":      testdata.GoDocMode,
+		"a/a_test.go": testdata.GoDocModeTest,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFlagsOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestOrphanedComments() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.CommentStructsFlag:          true,
+		commentmimic.CommentConstsFlag:           true,
+		commentmimic.CommentVarsFlag:             true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.OrphanedComments,
+		"a/a.go.golden": testdata.OrphanedCommentsGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestTrailingComments() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.CommentStructsFlag:          true,
+		commentmimic.CommentInterfacesFlag:       true,
+		commentmimic.CommentTypesFlag:            true,
+		commentmimic.CommentAliasesFlag:          true,
+		commentmimic.CheckTrailingCommentsFlag:   true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.TrailingComments,
+		"a/a.go.golden": testdata.TrailingCommentsGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestFirstWordNormalization() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.CommentStructsFlag:          true,
+		commentmimic.CheckValueFirstWordFlag:     true,
+		commentmimic.StripBackticksFlag:          true,
+		commentmimic.StripPunctuationFlag:        true,
+		commentmimic.StripPossessiveFlag:         true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.FirstWords,
+		"a/a.go.golden": testdata.FirstWordsGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestQualifiedMethodNames() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.CommentInterfacesFlag:       true,
+		commentmimic.QualifiedMethodNamesFlag:    true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.QualifiedNames,
+		"a/a.go.golden": testdata.QualifiedNamesGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestLeadWords() {
+	table := []struct {
+		name   string
+		flags  map[string]string
+		config string
+	}{
+		{
+			name: "Flags",
+			flags: map[string]string{
+				commentmimic.CommentStructsFlag:     "true",
+				commentmimic.CommentInterfacesFlag:  "true",
+				commentmimic.CheckArticlesFlag:      "true",
+				commentmimic.StructLeadWordsFlag:    "The",
+				commentmimic.InterfaceLeadWordsFlag: "A, An",
+				commentmimic.FuncLeadWordsFlag:      "",
+			},
+		},
+		{
+			name:   "Config",
+			config: testdata.LeadWordsConfig,
+		},
+	}
+
+	for _, test := range table {
+		test := test
+
+		s.T().Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fileMap := map[string]string{
+				"a/a.go":        testdata.LeadWords,
+				"a/a.go.golden": testdata.LeadWordsGolden,
+			}
+
+			if len(test.config) > 0 {
+				fileMap[".commentmimic.yml"] = test.config
+			}
+
+			dir, cleanup := writeTestFiles(t, fileMap)
+			defer cleanup()
+
+			mimic := newMimicWithFlagValues(t, test.flags)
+
+			if len(test.config) > 0 {
+				require.NoError(
+					t,
+					mimic.Flags.Set(
+						commentmimic.ConfigFlag,
+						filepath.Join(dir, "src", ".commentmimic.yml"),
+					),
+				)
+			}
+
+			analysistest.RunWithSuggestedFixes(t, dir, mimic, "a")
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestDocLinks() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CheckDocLinksFlag: true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.DocLinks,
+		"a/a.go.golden": testdata.DocLinksGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestParamNames() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CheckParamNamesFlag: true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.ParamNames,
+		"a/a.go.golden": testdata.ParamNamesGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestSignatureConventions() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CheckBoolVerbFlag:       true,
+		commentmimic.CheckConstructorDocFlag: true,
+		commentmimic.CheckErrorDocFlag:       true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":        testdata.Conventions,
+		"a/a.go.golden": testdata.ConventionsGolden,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFixesOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestLongAndComplexFuncs() {
+	t := s.T()
+	flags := map[string]string{
+		commentmimic.CommentLongFuncsFlag:    "5",
+		commentmimic.CommentComplexFuncsFlag: "2",
+	}
+
+	fileMap := map[string]string{
+		"a/a.go": testdata.LongFuncs,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	analysistest.Run(t, dir, newMimicWithFlagValues(t, flags), "a")
+}
+
+func (s *CommentMimicSuite) TestTestFuncSignatures() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+	}
+
+	fileMap := map[string]string{
+		"github.com/stretchr/testify/suite/suite.go": testdata.TestifySuite,
+		"a/a_test.go": testdata.TestFuncs,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFlagsOnFiles(t, flags, dir)
+}
+
+func (s *CommentMimicSuite) TestCustomTestFuncs() {
+	table := []struct {
+		name   string
+		flags  map[string]string
+		config string
+	}{
+		{
+			name: "Flags",
+			flags: map[string]string{
+				commentmimic.CommentAllExportedFuncsFlag: "true",
+				commentmimic.TestPrefixesFlag:            "Spec, Describe",
+				commentmimic.TestFilePatternsFlag:        "*_test.go,*_spec.go",
+			},
+		},
+		{
+			name:   "Config",
+			config: testdata.CustomTestFuncsConfig,
+		},
+	}
+
+	for _, test := range table {
+		test := test
+
+		s.T().Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fileMap := map[string]string{
+				"a/a.go":           testdata.CustomTestFuncsNotTest,
+				"a/a_test.go":      testdata.CustomTestFuncsTest,
+				"a/client_spec.go": testdata.CustomTestFuncs,
+			}
+
+			if len(test.config) > 0 {
+				fileMap[".commentmimic.yml"] = test.config
+			}
+
+			dir, cleanup := writeTestFiles(t, fileMap)
+			defer cleanup()
+
+			mimic := newMimicWithFlagValues(t, test.flags)
+
+			if len(test.config) > 0 {
+				require.NoError(
+					t,
+					mimic.Flags.Set(
+						commentmimic.ConfigFlag,
+						filepath.Join(dir, "src", ".commentmimic.yml"),
+					),
+				)
+			}
+
+			analysistest.Run(t, dir, mimic, "a")
+		})
+	}
+}
+
+func (s *CommentMimicSuite) TestSkipTestHelpers() {
+	t := s.T()
+	flags := map[string]bool{
+		commentmimic.CommentAllExportedFuncsFlag: true,
+		commentmimic.SkipTestHelpersFlag:         true,
+	}
+
+	fileMap := map[string]string{
+		"a/a.go":      testdata.TestHelpersNotTest,
+		"a/a_test.go": testdata.TestHelpers,
+	}
+
+	dir, cleanup := writeTestFiles(t, fileMap)
+	defer cleanup()
+
+	executeMimicWithFlagsOnFiles(t, flags, dir)
+}
@@ -0,0 +1,80 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+const (
+	longFuncTmpl    = "longer than %d lines"
+	complexFuncTmpl = "cyclomatic complexity over %d"
+)
+
+// bodyLines returns the number of lines between the braces of body.
+func bodyLines(fset *token.FileSet, body *ast.BlockStmt) int {
+	lines := fset.Position(body.Rbrace).Line - fset.Position(body.Lbrace).Line - 1
+	if lines < 0 {
+		return 0
+	}
+
+	return lines
+}
+
+// cyclomaticComplexity returns the cyclomatic complexity of body. This is one
+// plus the number of branches, where each if, for, range, non-default case, and
+// && or || operator is a branch. Function literals in body count towards its
+// complexity.
+func cyclomaticComplexity(body *ast.BlockStmt) int {
+	res := 1
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			res++
+
+		case *ast.CaseClause:
+			if n.List != nil {
+				res++
+			}
+
+		case *ast.CommClause:
+			if n.Comm != nil {
+				res++
+			}
+
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				res++
+			}
+		}
+
+		return true
+	})
+
+	return res
+}
+
+// exceededThreshold describes the threshold fun exceeds, either by having more
+// lines in its body than the long function threshold or a higher cyclomatic
+// complexity than the complex function threshold. Returns an empty string if
+// fun exceeds neither. Thresholds of 0 are disabled.
+func (m mimic) exceededThreshold(
+	fset *token.FileSet,
+	fun *ast.FuncDecl,
+) string {
+	if fun.Body == nil {
+		return ""
+	}
+
+	if m.commentLongFuncs > 0 && bodyLines(fset, fun.Body) > m.commentLongFuncs {
+		return fmt.Sprintf(longFuncTmpl, m.commentLongFuncs)
+	}
+
+	if m.commentComplexFuncs > 0 &&
+		cyclomaticComplexity(fun.Body) > m.commentComplexFuncs {
+		return fmt.Sprintf(complexFuncTmpl, m.commentComplexFuncs)
+	}
+
+	return ""
+}
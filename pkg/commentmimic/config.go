@@ -0,0 +1,436 @@
+package commentmimic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"gopkg.in/yaml.v3"
+)
+
+// options holds the settings from a config file. Settings that aren't in the
+// config file are nil so they don't change the current value.
+//
+//nolint:lll
+type options struct {
+	CommentExportedFuncs       *bool     `yaml:"comment-exported" json:"comment-exported"`
+	CommentAllExportedFuncs    *bool     `yaml:"comment-all-exported" json:"comment-all-exported"`
+	CommentInterfaces          *bool     `yaml:"comment-interfaces" json:"comment-interfaces"`
+	CommentStructs             *bool     `yaml:"comment-structs" json:"comment-structs"`
+	CommentTests               *bool     `yaml:"comment-tests" json:"comment-tests"`
+	CommentConsts              *bool     `yaml:"comment-consts" json:"comment-consts"`
+	CommentVars                *bool     `yaml:"comment-vars" json:"comment-vars"`
+	CheckValueFirstWord        *bool     `yaml:"check-value-first-word" json:"check-value-first-word"`
+	ValueBlockDoc              *bool     `yaml:"value-block-doc" json:"value-block-doc"`
+	RequireIgnoreReason        *bool     `yaml:"require-ignore-reason" json:"require-ignore-reason"`
+	RequirePackageComment      *bool     `yaml:"require-package-comment" json:"require-package-comment"`
+	CommentTypes               *bool     `yaml:"comment-types" json:"comment-types"`
+	CommentAliases             *bool     `yaml:"comment-aliases" json:"comment-aliases"`
+	AliasDocTarget             *bool     `yaml:"alias-doc-target" json:"alias-doc-target"`
+	TypeBlockDoc               *bool     `yaml:"type-block-doc" json:"type-block-doc"`
+	GoDoc                      *bool     `yaml:"go-doc" json:"go-doc"`
+	CommentStructFields        *bool     `yaml:"comment-struct-fields" json:"comment-struct-fields"`
+	RequireStructFieldComments *bool     `yaml:"require-struct-field-comments" json:"require-struct-field-comments"`
+	StripBackticks             *bool     `yaml:"strip-backticks" json:"strip-backticks"`
+	StripPunctuation           *bool     `yaml:"strip-punctuation" json:"strip-punctuation"`
+	StripPossessive            *bool     `yaml:"strip-possessive" json:"strip-possessive"`
+	QualifiedMethodNames       *bool     `yaml:"qualified-method-names" json:"qualified-method-names"`
+	CheckArticles              *bool     `yaml:"check-articles" json:"check-articles"`
+	CheckTrailingComments      *bool     `yaml:"check-trailing-comments" json:"check-trailing-comments"`
+	CheckDocLinks              *bool     `yaml:"check-doc-links" json:"check-doc-links"`
+	CheckParamNames            *bool     `yaml:"check-param-names" json:"check-param-names"`
+	CheckBoolVerb              *bool     `yaml:"check-bool-verb" json:"check-bool-verb"`
+	CheckConstructorDoc        *bool     `yaml:"check-constructor-doc" json:"check-constructor-doc"`
+	CheckErrorDoc              *bool     `yaml:"check-error-doc" json:"check-error-doc"`
+	CommentLongFuncs           *int      `yaml:"comment-long-funcs" json:"comment-long-funcs"`
+	CommentComplexFuncs        *int      `yaml:"comment-complex-funcs" json:"comment-complex-funcs"`
+	SkipTestHelpers            *bool     `yaml:"skip-test-helpers" json:"skip-test-helpers"`
+	StructLeadWords            *[]string `yaml:"struct-lead-words" json:"struct-lead-words"`
+	InterfaceLeadWords         *[]string `yaml:"interface-lead-words" json:"interface-lead-words"`
+	TypeLeadWords              *[]string `yaml:"type-lead-words" json:"type-lead-words"`
+	FuncLeadWords              *[]string `yaml:"func-lead-words" json:"func-lead-words"`
+	TestPrefixes               *[]string `yaml:"test-prefixes" json:"test-prefixes"`
+	TestFilePatterns           *[]string `yaml:"test-file-patterns" json:"test-file-patterns"`
+}
+
+func setBool(dst *bool, src *bool) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+func setInt(dst *int, src *int) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+// setWords replaces the words in dst with src if src isn't nil. An empty list
+// clears dst.
+func setWords(dst *wordSet, src *[]string) {
+	if src != nil {
+		*dst = newWordSet(strings.Join(*src, ","))
+	}
+}
+
+// apply sets the options in o that aren't nil on m.
+func (o options) apply(m *mimic) {
+	setBool(&m.commentExportedFuncs, o.CommentExportedFuncs)
+	setBool(&m.commentAllExportedFuncs, o.CommentAllExportedFuncs)
+	setBool(&m.commentInterfaces, o.CommentInterfaces)
+	setBool(&m.commentStructs, o.CommentStructs)
+	setBool(&m.commentTests, o.CommentTests)
+	setBool(&m.commentConsts, o.CommentConsts)
+	setBool(&m.commentVars, o.CommentVars)
+	setBool(&m.checkValueFirstWord, o.CheckValueFirstWord)
+	setBool(&m.valueBlockDoc, o.ValueBlockDoc)
+	setBool(&m.requireIgnoreReason, o.RequireIgnoreReason)
+	setBool(&m.requirePackageComment, o.RequirePackageComment)
+	setBool(&m.commentTypes, o.CommentTypes)
+	setBool(&m.commentAliases, o.CommentAliases)
+	setBool(&m.aliasDocTarget, o.AliasDocTarget)
+	setBool(&m.typeBlockDoc, o.TypeBlockDoc)
+	setBool(&m.goDoc, o.GoDoc)
+	setBool(&m.commentStructFields, o.CommentStructFields)
+	setBool(&m.requireStructFieldComments, o.RequireStructFieldComments)
+	setBool(&m.stripBackticks, o.StripBackticks)
+	setBool(&m.stripPunctuation, o.StripPunctuation)
+	setBool(&m.stripPossessive, o.StripPossessive)
+	setBool(&m.qualifiedMethodNames, o.QualifiedMethodNames)
+	setBool(&m.checkArticles, o.CheckArticles)
+	setBool(&m.checkTrailingComments, o.CheckTrailingComments)
+	setBool(&m.checkDocLinks, o.CheckDocLinks)
+	setBool(&m.checkParamNames, o.CheckParamNames)
+	setBool(&m.checkBoolVerb, o.CheckBoolVerb)
+	setBool(&m.checkConstructorDoc, o.CheckConstructorDoc)
+	setBool(&m.checkErrorDoc, o.CheckErrorDoc)
+	setInt(&m.commentLongFuncs, o.CommentLongFuncs)
+	setInt(&m.commentComplexFuncs, o.CommentComplexFuncs)
+	setBool(&m.skipTestHelpers, o.SkipTestHelpers)
+	setWords(&m.leadWords.structs, o.StructLeadWords)
+	setWords(&m.leadWords.interfaces, o.InterfaceLeadWords)
+	setWords(&m.leadWords.types, o.TypeLeadWords)
+	setWords(&m.leadWords.funcs, o.FuncLeadWords)
+	setWords(&m.tests.prefixes, o.TestPrefixes)
+	setWords(&m.tests.filePatterns, o.TestFilePatterns)
+}
+
+// override holds options that only apply to packages matching one of the glob
+// patterns in Paths.
+type override struct {
+	Paths   []string `yaml:"paths" json:"paths"`
+	options `yaml:",inline"`
+}
+
+// specificity returns how specific the most specific pattern in o matching one
+// of the given paths is. Returns -1 if no pattern matches.
+//
+// A pattern matches a path if it matches the path itself or any of the parent
+// directories of the path. More specific patterns have more path elements.
+func (o override) specificity(paths ...string) (int, error) {
+	res := -1
+
+	for _, pattern := range o.Paths {
+		pattern = strings.Trim(path.Clean(pattern), "/")
+
+		for _, p := range paths {
+			if len(p) == 0 {
+				continue
+			}
+
+			matched, err := matchPathOrParent(pattern, p)
+			if err != nil {
+				return -1, fmt.Errorf("bad override pattern %q: %w", pattern, err)
+			}
+
+			if !matched {
+				continue
+			}
+
+			if n := strings.Count(pattern, "/") + 1; n > res {
+				res = n
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func matchPathOrParent(pattern string, p string) (bool, error) {
+	for ; p != "." && p != "/"; p = path.Dir(p) {
+		matched, err := path.Match(pattern, p)
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+
+	return false, nil
+}
+
+// config is the layout of a config file. Options at the top level apply to all
+// packages. Overrides change options for the packages they match.
+type config struct {
+	options   `yaml:",inline"`
+	Overrides []override `yaml:"overrides" json:"overrides"`
+
+	// dir is the directory containing the config file. Paths of packages are
+	// made relative to it before matching overrides.
+	dir string
+}
+
+// parseConfig parses the contents of a config file. Files ending with ".json"
+// are parsed as JSON, everything else is parsed as YAML. Unknown options are
+// reported as errors.
+func parseConfig(name string, data []byte) (*config, error) {
+	cfg := &config{}
+
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+
+		if err := dec.Decode(cfg); err != nil {
+			return nil, fmt.Errorf("parsing config file %s: %w", name, err)
+		}
+
+		return cfg, nil
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	// An empty file leaves all the options unset.
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parsing config file %s: %w", name, err)
+	}
+
+	return cfg, nil
+}
+
+func loadConfig(name string) (*config, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	cfg, err := parseConfig(name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return nil, fmt.Errorf("finding config file directory: %w", err)
+	}
+
+	cfg.dir = filepath.Dir(abs)
+
+	return cfg, nil
+}
+
+// packageDir returns the directory of the package being analyzed relative to
+// dir, using forward slashes. Returns an empty string if the package is outside
+// of dir.
+func packageDir(pass *analysis.Pass, dir string) string {
+	if len(pass.Files) == 0 {
+		return ""
+	}
+
+	f := pass.Fset.File(pass.Files[0].Pos())
+	if f == nil {
+		return ""
+	}
+
+	rel, err := filepath.Rel(dir, filepath.Dir(f.Name()))
+	if err != nil {
+		return ""
+	}
+
+	rel = filepath.ToSlash(rel)
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return ""
+	}
+
+	return rel
+}
+
+// apply sets the options from the config file on m for the package being
+// analyzed by pass. Top-level options are applied first, followed by the
+// options of each override with a pattern matching the package, from the least
+// specific to the most specific pattern. The closest matching override setting
+// an option wins. Patterns are matched against both the import path of the
+// package and the directory of the package relative to the config file. If
+// several overrides are equally specific the last one in the file wins.
+func (c *config) apply(pass *analysis.Pass, m *mimic) error {
+	type match struct {
+		o     *override
+		score int
+	}
+
+	var (
+		matches []match
+		pkgDir  = packageDir(pass, c.dir)
+	)
+
+	for i := range c.Overrides {
+		score, err := c.Overrides[i].specificity(pass.Pkg.Path(), pkgDir)
+		if err != nil {
+			return err
+		}
+
+		if score < 0 {
+			continue
+		}
+
+		matches = append(matches, match{o: &c.Overrides[i], score: score})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score < matches[j].score
+	})
+
+	c.options.apply(m)
+
+	for _, match := range matches {
+		match.o.options.apply(m)
+	}
+
+	return nil
+}
+
+// givenFlags holds the values of the flags that were given on the command line
+// by name so they can take precedence over the config file.
+type givenFlags map[string]flag.Getter
+
+// givenFlag wraps the value of a flag to record when the flag is given.
+type givenFlag struct {
+	flag.Getter
+	name  string
+	given givenFlags
+}
+
+// String returns the value of the flag. The flag package calls it on the zero
+// value when printing defaults so it can't assume a value is wrapped.
+func (f givenFlag) String() string {
+	if f.Getter == nil {
+		return ""
+	}
+
+	return f.Getter.String()
+}
+
+func (f givenFlag) Set(value string) error {
+	if err := f.Getter.Set(value); err != nil {
+		return err
+	}
+
+	f.given[f.name] = f.Getter
+
+	return nil
+}
+
+// givenBoolFlag is a givenFlag for a boolean flag so it can be given without a
+// value and its default is printed like the default of other boolean flags.
+type givenBoolFlag struct {
+	givenFlag
+}
+
+func (f givenBoolFlag) String() string {
+	if f.Getter == nil {
+		return strconv.FormatBool(false)
+	}
+
+	return f.Getter.String()
+}
+
+func (f givenBoolFlag) IsBoolFlag() bool {
+	return true
+}
+
+// givenIntFlag is a givenFlag for an int flag so its default is printed like
+// the default of other int flags.
+type givenIntFlag struct {
+	givenFlag
+}
+
+func (f givenIntFlag) String() string {
+	if f.Getter == nil {
+		return strconv.Itoa(0)
+	}
+
+	return f.Getter.String()
+}
+
+// track wraps the values of the flags in fs so g records which are given. Only
+// flags for options are tracked. String flags, like the config file, aren't
+// options so they're left alone.
+func (g givenFlags) track(fs *flag.FlagSet) {
+	fs.VisitAll(func(f *flag.Flag) {
+		getter, ok := f.Value.(flag.Getter)
+		if !ok {
+			return
+		}
+
+		wrapped := givenFlag{Getter: getter, name: f.Name, given: g}
+
+		switch getter.Get().(type) {
+		case bool:
+			f.Value = givenBoolFlag{wrapped}
+
+		case int:
+			f.Value = givenIntFlag{wrapped}
+
+		case []string:
+			f.Value = wrapped
+		}
+	})
+}
+
+// options returns the options set by the given flags. Given flags that aren't
+// options, like the config file itself, are ignored.
+func (g givenFlags) options() (options, error) {
+	values := make(map[string]any, len(g))
+
+	for name, value := range g {
+		values[name] = value.Get()
+	}
+
+	var res options
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return res, fmt.Errorf("reading flags: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &res); err != nil {
+		return res, fmt.Errorf("reading flags: %w", err)
+	}
+
+	return res, nil
+}
+
+// configLoader loads the config file the first time it's needed so it's only
+// read once even if packages are analyzed in parallel.
+type configLoader struct {
+	once sync.Once
+	cfg  *config
+	err  error
+}
+
+func (l *configLoader) load(name string) (*config, error) {
+	l.once.Do(func() {
+		l.cfg, l.err = loadConfig(name)
+	})
+
+	return l.cfg, l.err
+}
@@ -0,0 +1,470 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/doc/comment"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	docLinkTmpl = "doc link '[%s]' doesn't refer to an existing identifier"
+
+	// maxSuggestionDistance is the largest edit distance between a missing name
+	// and an existing one for the existing name to be suggested as a fix.
+	maxSuggestionDistance = 2
+
+	ruleDocLink = "doc-link"
+)
+
+// docComment is a doc comment along with the name of the element it documents.
+// receiver is the type the element belongs to if it's a method or interface
+// method.
+type docComment struct {
+	name     string
+	receiver string
+	doc      *ast.CommentGroup
+}
+
+// fileDocComments returns the doc comments in f, including the package comment
+// and comments on specs in grouped declarations, struct fields, and interface
+// methods.
+func fileDocComments(f *ast.File) []docComment {
+	var res []docComment
+
+	add := func(name string, receiver string, doc *ast.CommentGroup) {
+		if doc != nil {
+			res = append(res, docComment{
+				name:     name,
+				receiver: receiver,
+				doc:      doc,
+			})
+		}
+	}
+
+	add(f.Name.Name, "", f.Doc)
+
+	// ifaceMethods holds the name of the interface each interface method is
+	// declared in. Interfaces are visited before their methods.
+	ifaceMethods := map[*ast.Field]string{}
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncDecl:
+			receiver := ""
+
+			if n.Recv != nil && len(n.Recv.List) > 0 {
+				if ident := receiverIdent(n.Recv.List[0].Type); ident != nil {
+					receiver = ident.Name
+				}
+			}
+
+			add(n.Name.Name, receiver, n.Doc)
+
+		case *ast.GenDecl:
+			if len(n.Specs) > 0 {
+				add(specName(n.Specs[0]), "", n.Doc)
+			}
+
+		case *ast.TypeSpec:
+			add(n.Name.Name, "", n.Doc)
+
+			if iface, ok := n.Type.(*ast.InterfaceType); ok {
+				for _, field := range iface.Methods.List {
+					ifaceMethods[field] = n.Name.Name
+				}
+			}
+
+		case *ast.ValueSpec:
+			add(specName(n), "", n.Doc)
+
+		case *ast.Field:
+			if len(n.Names) > 0 {
+				add(n.Names[0].Name, ifaceMethods[n], n.Doc)
+			} else if ident := receiverIdent(n.Type); ident != nil {
+				add(ident.Name, "", n.Doc)
+			}
+		}
+
+		return true
+	})
+
+	return res
+}
+
+// specName returns the name of the first element declared by spec.
+func specName(spec ast.Spec) string {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return s.Name.Name
+
+	case *ast.ValueSpec:
+		if len(s.Names) > 0 {
+			return s.Names[0].Name
+		}
+
+	case *ast.ImportSpec:
+		return s.Path.Value
+	}
+
+	return ""
+}
+
+// importedPackages returns the packages imported by f keyed by the name they're
+// referred to by in f. Blank and dot imports aren't included.
+func importedPackages(
+	info *types.Info,
+	f *ast.File,
+) map[string]*types.Package {
+	res := map[string]*types.Package{}
+
+	for _, imp := range f.Imports {
+		var obj types.Object
+
+		if imp.Name != nil {
+			obj = info.Defs[imp.Name]
+		} else {
+			obj = info.Implicits[imp]
+		}
+
+		if pkgName, ok := obj.(*types.PkgName); ok {
+			res[pkgName.Name()] = pkgName.Imported()
+		}
+	}
+
+	return res
+}
+
+// docLinks returns the doc links in blocks in the order they appear in.
+func docLinks(blocks []comment.Block) []*comment.DocLink {
+	var res []*comment.DocLink
+
+	addText := func(text []comment.Text) {
+		for _, t := range text {
+			if link, ok := t.(*comment.DocLink); ok {
+				res = append(res, link)
+			}
+		}
+	}
+
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case *comment.Paragraph:
+			addText(b.Text)
+
+		case *comment.Heading:
+			addText(b.Text)
+
+		case *comment.List:
+			for _, item := range b.Items {
+				res = append(res, docLinks(item.Content)...)
+			}
+		}
+	}
+
+	return res
+}
+
+// plainText returns the text of a doc link as it's written in the comment.
+func plainText(text []comment.Text) string {
+	var sb strings.Builder
+
+	for _, t := range text {
+		if p, ok := t.(comment.Plain); ok {
+			sb.WriteString(string(p))
+		}
+	}
+
+	return sb.String()
+}
+
+// exportedNames returns the exported names in scope.
+func exportedNames(scope *types.Scope) []string {
+	var res []string
+
+	for _, name := range scope.Names() {
+		if ast.IsExported(name) {
+			res = append(res, name)
+		}
+	}
+
+	return res
+}
+
+// memberNames returns the exported methods and fields of t.
+func memberNames(t types.Type) []string {
+	var res []string
+
+	mset := types.NewMethodSet(types.NewPointer(t))
+	for i := 0; i < mset.Len(); i++ {
+		if name := mset.At(i).Obj().Name(); ast.IsExported(name) {
+			res = append(res, name)
+		}
+	}
+
+	if st, ok := t.Underlying().(*types.Struct); ok {
+		for i := 0; i < st.NumFields(); i++ {
+			if name := st.Field(i).Name(); ast.IsExported(name) {
+				res = append(res, name)
+			}
+		}
+	}
+
+	return res
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a string, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = prev[j-1] + cost
+
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// closestName returns the name in candidates closest to name by edit distance.
+// Returns false if no name is close enough or several names are equally close.
+func closestName(name string, candidates []string) (string, bool) {
+	var (
+		best     string
+		bestDist = maxSuggestionDistance + 1
+		unique   bool
+	)
+
+	for _, candidate := range candidates {
+		dist := editDistance(name, candidate)
+
+		switch {
+		case dist < bestDist:
+			best = candidate
+			bestDist = dist
+			unique = true
+
+		case dist == bestDist:
+			unique = false
+		}
+	}
+
+	if !unique || bestDist >= len([]rune(name)) {
+		return "", false
+	}
+
+	return best, true
+}
+
+// missingLinkPart is the part of a doc link that doesn't resolve along with the
+// names that could have been meant instead.
+type missingLinkPart struct {
+	name       string
+	candidates []string
+	// fromEnd is the offset of the end of the name from the end of the link
+	// text.
+	fromEnd int
+}
+
+// resolveDocLink returns the part of link that doesn't refer to an existing
+// identifier. Returns false if link resolves or can't be checked, like links
+// to packages that aren't imported.
+func resolveDocLink(
+	pkg *types.Package,
+	link *comment.DocLink,
+) (missingLinkPart, bool) {
+	scope := pkg.Scope()
+
+	if len(link.ImportPath) > 0 && link.ImportPath != pkg.Path() {
+		scope = nil
+
+		for _, imp := range pkg.Imports() {
+			if imp.Path() == link.ImportPath {
+				scope = imp.Scope()
+				break
+			}
+		}
+
+		// Links to packages themselves always resolve.
+		if scope == nil || len(link.Name) == 0 {
+			return missingLinkPart{}, false
+		}
+	}
+
+	if len(link.Recv) == 0 {
+		if scope.Lookup(link.Name) != nil ||
+			(scope == pkg.Scope() && types.Universe.Lookup(link.Name) != nil) {
+			return missingLinkPart{}, false
+		}
+
+		return missingLinkPart{
+			name:       link.Name,
+			candidates: exportedNames(scope),
+		}, true
+	}
+
+	recv, ok := scope.Lookup(link.Recv).(*types.TypeName)
+	if !ok {
+		return missingLinkPart{
+			name:       link.Recv,
+			candidates: exportedNames(scope),
+			fromEnd:    len(link.Name) + 1,
+		}, true
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(recv.Type(), true, pkg, link.Name)
+	if obj != nil {
+		return missingLinkPart{}, false
+	}
+
+	return missingLinkPart{
+		name:       link.Name,
+		candidates: memberNames(recv.Type()),
+	}, true
+}
+
+// commentFinder finds text in the comments of a comment group. Text is searched
+// for after the end of the last text found so repeated text is found in order.
+type commentFinder struct {
+	comments []*ast.Comment
+	idx      int
+	offset   int
+}
+
+func (cf *commentFinder) find(text string) (token.Pos, bool) {
+	for ; cf.idx < len(cf.comments); cf.idx, cf.offset = cf.idx+1, 0 {
+		c := cf.comments[cf.idx]
+
+		if i := strings.Index(c.Text[cf.offset:], text); i >= 0 {
+			pos := c.Pos() + token.Pos(cf.offset+i)
+			cf.offset += i + len(text)
+
+			return pos, true
+		}
+	}
+
+	return token.NoPos, false
+}
+
+// checkDocLinks reports doc links like [Name], [Type.Method], or
+// [pkg.Name] in doc comments that don't refer to an existing identifier. Links
+// are resolved against the scope of the package being analyzed and the
+// packages it imports. Links to packages that aren't imported can't be checked
+// and are skipped. If a single existing identifier is a close match for the
+// missing one, a fix replacing the name is suggested.
+func checkDocLinks(r *reporter) {
+	for _, f := range r.pass.Files {
+		imports := importedPackages(r.pass.TypesInfo, f)
+
+		parser := &comment.Parser{
+			LookupPackage: func(name string) (string, bool) {
+				if name == r.pass.Pkg.Name() {
+					return "", true
+				}
+
+				if pkg, ok := imports[name]; ok {
+					return pkg.Path(), true
+				}
+
+				return "", false
+			},
+			// Treat all symbols as existing so links to missing symbols are parsed
+			// as links.
+			LookupSym: func(string, string) bool { return true },
+		}
+
+		for _, dc := range fileDocComments(f) {
+			checkCommentDocLinks(r, parser, dc)
+		}
+	}
+}
+
+func checkCommentDocLinks(
+	r *reporter,
+	parser *comment.Parser,
+	dc docComment,
+) {
+	finder := &commentFinder{comments: dc.doc.List}
+
+	for _, link := range docLinks(parser.Parse(dc.doc.Text()).Content) {
+		text := plainText(link.Text)
+		raw := "[" + text + "]"
+
+		// Find the link even if it doesn't resolve so later links with the same
+		// text are found in the right place.
+		pos, found := finder.find(raw)
+
+		missing, ok := resolveDocLink(r.pass.Pkg, link)
+		if !ok {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      dc.doc.Pos(),
+			End:      dc.doc.End(),
+			Category: ruleDocLink,
+			Message:  fmt.Sprintf(docLinkTmpl, text),
+		}
+
+		if found {
+			diag.Pos = pos
+			diag.End = pos + token.Pos(len(raw))
+		}
+
+		suggestion, ok := closestName(missing.name, missing.candidates)
+		if found && ok {
+			// The link text starts after the opening bracket.
+			end := pos + token.Pos(1+len(text)-missing.fromEnd)
+
+			diag.SuggestedFixes = []analysis.SuggestedFix{
+				{
+					Message: fmt.Sprintf(
+						replaceWordFixTmpl,
+						missing.name,
+						suggestion,
+					),
+					TextEdits: []analysis.TextEdit{
+						{
+							Pos:     end - token.Pos(len(missing.name)),
+							End:     end,
+							NewText: []byte(suggestion),
+						},
+					},
+				},
+			}
+		}
+
+		r.receiver = dc.receiver
+		r.report(dc.name, diag)
+		r.receiver = ""
+	}
+}
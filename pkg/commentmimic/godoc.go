@@ -0,0 +1,134 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const goFileSuffix = ".go"
+
+// goDocs holds the comments of the elements in a package as go/doc associates
+// them. Only elements where go/doc can associate a different comment than the
+// one attached to the element in the AST are tracked. Comments on functions and
+// methods are the same either way, even though go/doc lists constructors and
+// methods under their types.
+type goDocs struct {
+	// types holds the comment of each type. go/doc uses the comment on a type
+	// block for all types in the block without a comment of their own.
+	types map[*ast.TypeSpec]*ast.CommentGroup
+	// values holds the comment describing each const and var declaration. go/doc
+	// shows the comment on a block as the comment of the whole block.
+	values map[*ast.GenDecl]*ast.CommentGroup
+}
+
+// newGoDocs builds a go/doc package from the non-test files of the package
+// being analyzed by pass. Test files aren't part of the package go/doc shows so
+// elements in them keep the comments attached in the AST. Like go doc, only
+// _test.go files count as test files here whatever the test file patterns are.
+// Generated files without a .go extension, like the main package of test
+// binaries, are skipped too since go/doc can't handle them. The files in pass
+// aren't changed.
+func newGoDocs(pass *analysis.Pass) (*goDocs, error) {
+	var files []*ast.File
+
+	for _, f := range pass.Files {
+		tf := pass.Fset.File(f.Pos())
+		if tf == nil ||
+			!strings.HasSuffix(tf.Name(), goFileSuffix) ||
+			strings.HasSuffix(tf.Name(), testFileNameSuffix) {
+			continue
+		}
+
+		// go/doc resolves the unresolved identifiers of the files it's given,
+		// which changes ASTs other analyzers may be reading at the same time.
+		// Shallow copies without unresolved identifiers leave it nothing to
+		// change. The declarations are shared so they can still be looked up.
+		fileCopy := *f
+		fileCopy.Unresolved = nil
+
+		files = append(files, &fileCopy)
+	}
+
+	res := &goDocs{
+		types:  map[*ast.TypeSpec]*ast.CommentGroup{},
+		values: map[*ast.GenDecl]*ast.CommentGroup{},
+	}
+
+	if len(files) == 0 {
+		return res, nil
+	}
+
+	// PreserveAST keeps go/doc from removing comments from the AST since they're
+	// still needed to report findings.
+	p, err := doc.NewFromFiles(
+		pass.Fset,
+		files,
+		pass.Pkg.Path(),
+		doc.AllDecls|doc.PreserveAST,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("building go/doc package: %w", err)
+	}
+
+	res.addValues(p.Consts)
+	res.addValues(p.Vars)
+
+	for _, t := range p.Types {
+		res.addValues(t.Consts)
+		res.addValues(t.Vars)
+
+		if t.Decl == nil || len(t.Decl.Specs) == 0 {
+			continue
+		}
+
+		ts, ok := t.Decl.Specs[0].(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+
+		// The declaration go/doc returns may not be the one in the AST but it has
+		// the same comment as the block the type was declared in.
+		comment := ts.Doc
+		if comment == nil {
+			comment = t.Decl.Doc
+		}
+
+		res.types[ts] = comment
+	}
+
+	return res, nil
+}
+
+func (gd *goDocs) addValues(values []*doc.Value) {
+	for _, v := range values {
+		gd.values[v.Decl] = v.Decl.Doc
+	}
+}
+
+// typeDoc returns the comment go/doc associates with ts. Returns false if
+// go/doc doesn't know about ts or go/doc mode is disabled.
+func (gd *goDocs) typeDoc(ts *ast.TypeSpec) (*ast.CommentGroup, bool) {
+	if gd == nil {
+		return nil, false
+	}
+
+	comment, ok := gd.types[ts]
+
+	return comment, ok
+}
+
+// valueDoc returns the comment go/doc shows for the const or var block decl.
+// Returns false if go/doc doesn't know about decl or go/doc mode is disabled.
+func (gd *goDocs) valueDoc(decl *ast.GenDecl) (*ast.CommentGroup, bool) {
+	if gd == nil {
+		return nil, false
+	}
+
+	comment, ok := gd.values[decl]
+
+	return comment, ok
+}
@@ -0,0 +1,224 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	ignoreDirectivePrefix = "//commentmimic:"
+	ignoreVerb            = "ignore"
+	ignoreFileVerb        = "ignore-file"
+
+	ignoreUnusedTmpl   = "unused commentmimic:%s directive"
+	ignoreNoReasonTmpl = "commentmimic:%s directive is missing a reason"
+
+	ruleUnusedIgnore = "unused-ignore"
+	ruleIgnoreReason = "ignore-reason"
+)
+
+// suppressibleRules are the rules that can be named in a suppression directive.
+var suppressibleRules = map[string]struct{}{
+	ruleMismatch: {},
+	ruleEmpty:    {},
+	ruleMissing:  {},
+
+	rulePackageConflict: {},
+	ruleAliasTarget:     {},
+	ruleBlockConflict:   {},
+	ruleOrphaned:        {},
+	ruleTrailing:        {},
+	ruleReceiver:        {},
+	ruleArticle:         {},
+	ruleDocLink:         {},
+	ruleParamName:       {},
+	ruleBoolVerb:        {},
+	ruleConstructorDoc:  {},
+	ruleErrorDoc:        {},
+}
+
+// ignoreDirective is a single //commentmimic:ignore or
+// //commentmimic:ignore-file comment.
+type ignoreDirective struct {
+	pos  token.Pos
+	verb string
+	// rules the directive suppresses. All rules are suppressed if rules is
+	// empty.
+	rules  map[string]struct{}
+	reason string
+
+	// Diagnostics on lines [fromLine, toLine] are suppressed. Directives for the
+	// whole file suppress diagnostics on all lines.
+	wholeFile bool
+	fromLine  int
+	toLine    int
+
+	used bool
+}
+
+// parseIgnoreDirective returns the directive in c or nil if c isn't a
+// suppression directive. Directives have the form
+//
+//	//commentmimic:ignore [rule[,rule...]] [reason]
+//	//commentmimic:ignore-file [rule[,rule...]] [reason]
+//
+// If the first word after the directive isn't a list of known rules it's
+// treated as the start of the reason.
+func parseIgnoreDirective(c *ast.Comment) *ignoreDirective {
+	if !strings.HasPrefix(c.Text, ignoreDirectivePrefix) {
+		return nil
+	}
+
+	words := strings.Fields(strings.TrimPrefix(c.Text, ignoreDirectivePrefix))
+	if len(words) == 0 {
+		return nil
+	}
+
+	d := &ignoreDirective{
+		pos:   c.Pos(),
+		verb:  words[0],
+		rules: map[string]struct{}{},
+	}
+
+	switch d.verb {
+	case ignoreVerb:
+	case ignoreFileVerb:
+		d.wholeFile = true
+	default:
+		return nil
+	}
+
+	words = words[1:]
+
+	if len(words) > 0 {
+		rules := strings.Split(words[0], ",")
+		known := true
+
+		for _, r := range rules {
+			if _, ok := suppressibleRules[r]; !ok {
+				known = false
+				break
+			}
+		}
+
+		if known {
+			for _, r := range rules {
+				d.rules[r] = struct{}{}
+			}
+
+			words = words[1:]
+		}
+	}
+
+	d.reason = strings.Join(words, " ")
+
+	return d
+}
+
+// ignoreDirectives holds the suppression directives for all files in a pass.
+type ignoreDirectives struct {
+	fset       *token.FileSet
+	directives map[*token.File][]*ignoreDirective
+	// order of files so unused directives are reported in a stable order.
+	order []*token.File
+}
+
+// newIgnoreDirectives finds all suppression directives in the files of pass.
+// A directive applies to the element the comment group containing it is
+// attached to, which is the element on the line after the end of the comment
+// group.
+func newIgnoreDirectives(pass *analysis.Pass) *ignoreDirectives {
+	res := &ignoreDirectives{
+		fset:       pass.Fset,
+		directives: map[*token.File][]*ignoreDirective{},
+	}
+
+	for _, f := range pass.Files {
+		tf := pass.Fset.File(f.Pos())
+		if tf == nil {
+			continue
+		}
+
+		res.order = append(res.order, tf)
+
+		for _, group := range f.Comments {
+			for _, c := range group.List {
+				d := parseIgnoreDirective(c)
+				if d == nil {
+					continue
+				}
+
+				d.fromLine = tf.Line(group.Pos())
+				d.toLine = tf.Line(group.End()) + 1
+
+				res.directives[tf] = append(res.directives[tf], d)
+			}
+		}
+	}
+
+	return res
+}
+
+// suppress returns true if one of the directives suppresses d. All directives
+// that suppress d are marked as used.
+func (ids *ignoreDirectives) suppress(d analysis.Diagnostic) bool {
+	if _, ok := suppressibleRules[d.Category]; !ok {
+		return false
+	}
+
+	tf := ids.fset.File(d.Pos)
+	if tf == nil {
+		return false
+	}
+
+	line := tf.Line(d.Pos)
+	suppressed := false
+
+	for _, dir := range ids.directives[tf] {
+		if len(dir.rules) > 0 {
+			if _, ok := dir.rules[d.Category]; !ok {
+				continue
+			}
+		}
+
+		if !dir.wholeFile && (line < dir.fromLine || line > dir.toLine) {
+			continue
+		}
+
+		dir.used = true
+		suppressed = true
+	}
+
+	return suppressed
+}
+
+// report reports directives that didn't suppress anything and, if
+// requireReason is set, directives without a reason.
+func (ids *ignoreDirectives) report(
+	pass *analysis.Pass,
+	requireReason bool,
+) {
+	for _, tf := range ids.order {
+		for _, dir := range ids.directives[tf] {
+			if requireReason && len(dir.reason) == 0 {
+				pass.Report(analysis.Diagnostic{
+					Pos:      dir.pos,
+					Category: ruleIgnoreReason,
+					Message:  fmt.Sprintf(ignoreNoReasonTmpl, dir.verb),
+				})
+			}
+
+			if !dir.used {
+				pass.Report(analysis.Diagnostic{
+					Pos:      dir.pos,
+					Category: ruleUnusedIgnore,
+					Message:  fmt.Sprintf(ignoreUnusedTmpl, dir.verb),
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	articleMismatchTmpl = "comment should start with '%s' instead of '%s' " +
+		"before '%s'"
+
+	articleA  = "A"
+	articleAn = "An"
+
+	// anLetters are the letters with names starting with a vowel sound.
+	// Initialisms starting with them take "An", like "An HTTPClient".
+	anLetters = "AEFHILMNORSX"
+	vowels    = "aeiou"
+
+	defaultTypeLeadWords = articleA + "," + articleAn
+
+	ruleArticle = "article"
+)
+
+var (
+	// consonantSoundPrefixes are prefixes of words starting with a vowel that
+	// are pronounced with a consonant sound, like "A User".
+	consonantSoundPrefixes = []string{
+		"eu",
+		"one",
+		"once",
+		"ubi",
+		"uni",
+		"ura",
+		"usa",
+		"use",
+		"usu",
+		"uti",
+	}
+
+	// vowelSoundPrefixes are prefixes of words starting with a consonant that
+	// are pronounced with a vowel sound, like "An Hour".
+	vowelSoundPrefixes = []string{
+		"heir",
+		"honest",
+		"honor",
+		"hour",
+	}
+)
+
+// wordSet is a set of words that can be set from a comma separated flag.
+type wordSet map[string]struct{}
+
+func newWordSet(s string) wordSet {
+	res := wordSet{}
+
+	for _, word := range strings.Split(s, ",") {
+		if word = strings.TrimSpace(word); len(word) > 0 {
+			res[word] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// sorted returns the words in ws in sorted order.
+func (ws wordSet) sorted() []string {
+	words := make([]string, 0, len(ws))
+	for word := range ws {
+		words = append(words, word)
+	}
+
+	sort.Strings(words)
+
+	return words
+}
+
+// String returns the words in ws as a sorted, comma separated list.
+func (ws wordSet) String() string {
+	return strings.Join(ws.sorted(), ",")
+}
+
+// Get returns the words in ws as a sorted list.
+func (ws wordSet) Get() any {
+	return ws.sorted()
+}
+
+// Set replaces the words in ws with the words in the comma separated list s. A
+// new map is used so copies of ws aren't changed.
+func (ws *wordSet) Set(s string) error {
+	*ws = newWordSet(s)
+	return nil
+}
+
+// leadWordSets holds the lead words allowed before the name of each kind of
+// element in comments.
+type leadWordSets struct {
+	structs    wordSet
+	interfaces wordSet
+	// types holds the lead words for other named types and aliases.
+	types wordSet
+	// funcs holds the lead words for functions and methods, including interface
+	// methods.
+	funcs wordSet
+}
+
+// forType returns the lead words allowed in comments on the type declared by
+// ts.
+func (lw leadWordSets) forType(ts *ast.TypeSpec) wordSet {
+	if ts.Assign != token.NoPos {
+		return lw.types
+	}
+
+	switch ts.Type.(type) {
+	case *ast.StructType:
+		return lw.structs
+
+	case *ast.InterfaceType:
+		return lw.interfaces
+
+	default:
+		return lw.types
+	}
+}
+
+// wantsAn returns true if name starts with a vowel sound and should be preceded
+// by "An" instead of "A". This is a heuristic based on the spelling of name so
+// it doesn't know how every word is pronounced.
+func wantsAn(name string) bool {
+	first, size := utf8.DecodeRuneInString(name)
+	if first == utf8.RuneError {
+		return false
+	}
+
+	// Initialisms are read letter by letter.
+	second, _ := utf8.DecodeRuneInString(name[size:])
+	if unicode.IsUpper(first) && unicode.IsUpper(second) {
+		return strings.ContainsRune(anLetters, first)
+	}
+
+	lower := strings.ToLower(name)
+
+	for _, prefix := range consonantSoundPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return false
+		}
+	}
+
+	for _, prefix := range vowelSoundPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+
+	return strings.ContainsRune(vowels, unicode.ToLower(first))
+}
+
+// checkArticle reports to r if comment starts with the lead word "A" or "An"
+// followed by elementName and the lead word doesn't agree with the sound
+// elementName starts with, like "A Iterator" or "An User". A fix replacing the
+// lead word is suggested.
+func checkArticle(
+	r *reporter,
+	elementName string,
+	comment *ast.CommentGroup,
+	words []string,
+	leadWords map[string]struct{},
+) {
+	if len(words) < 2 || r.words.normalize(words[1]) != elementName {
+		return
+	}
+
+	core, offset := r.words.core(words[0])
+	if _, ok := leadWords[core]; !ok || (core != articleA && core != articleAn) {
+		return
+	}
+
+	want := articleA
+	if wantsAn(elementName) {
+		want = articleAn
+	}
+
+	if core == want {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      comment.Pos(),
+		End:      comment.End(),
+		Category: ruleArticle,
+		Message:  fmt.Sprintf(articleMismatchTmpl, want, core, elementName),
+	}
+
+	if start, _, ok := commentWordPos(comment, 0); ok {
+		start += token.Pos(offset)
+
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf(replaceWordFixTmpl, core, want),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     start,
+						End:     start + token.Pos(len(core)),
+						NewText: []byte(want),
+					},
+				},
+			},
+		}
+	}
+
+	r.report(elementName, diag)
+}
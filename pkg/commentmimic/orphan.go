@@ -0,0 +1,205 @@
+package commentmimic
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	orphanedCommentTmpl = "comment for '%s' is separated from it by a " +
+		"blank line"
+	removeBlankLineFixTmpl = "remove blank line between comment and '%s'"
+
+	ruleOrphaned = "orphaned"
+)
+
+// orphanCandidate is an exported element without a comment that may have an
+// orphaned comment above it.
+type orphanCandidate struct {
+	names     []*ast.Ident
+	pos       token.Pos
+	leadWords map[string]struct{}
+	// receiver is the name of the receiver type if the element is a method.
+	receiver string
+}
+
+// orphanCandidates returns the exported top-level elements in f that don't
+// have a comment, keyed by the line they start on. Specs in grouped
+// declarations are included as well.
+func orphanCandidates(
+	tf *token.File,
+	f *ast.File,
+	leadWords leadWordSets,
+) map[int]orphanCandidate {
+	res := map[int]orphanCandidate{}
+
+	add := func(
+		names []*ast.Ident,
+		pos token.Pos,
+		leadWords map[string]struct{},
+	) {
+		for _, ident := range names {
+			if ident.IsExported() {
+				res[tf.Line(pos)] = orphanCandidate{
+					names:     names,
+					pos:       pos,
+					leadWords: leadWords,
+				}
+
+				return
+			}
+		}
+	}
+
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Doc != nil {
+				continue
+			}
+
+			add([]*ast.Ident{d.Name}, d.Pos(), leadWords.funcs)
+
+			line := tf.Line(d.Pos())
+			if c, ok := res[line]; ok && d.Recv != nil {
+				if ident := receiverIdent(d.Recv.List[0].Type); ident != nil {
+					c.receiver = ident.Name
+					res[line] = c
+				}
+			}
+
+		case *ast.GenDecl:
+			grouped := d.Lparen != token.NoPos
+			if !grouped && d.Doc != nil {
+				continue
+			}
+
+			for _, spec := range d.Specs {
+				pos := d.Pos()
+				if grouped {
+					pos = spec.Pos()
+				}
+
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if !grouped || s.Doc == nil {
+						add([]*ast.Ident{s.Name}, pos, leadWords.forType(s))
+					}
+
+				case *ast.ValueSpec:
+					if !grouped || s.Doc == nil {
+						add(s.Names, pos, nil)
+					}
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// isBlankLine returns true if line of the file tf only has whitespace in src.
+func isBlankLine(tf *token.File, src []byte, line int) bool {
+	if line < 1 || line >= tf.LineCount() {
+		return false
+	}
+
+	start := tf.Offset(tf.LineStart(line))
+	end := tf.Offset(tf.LineStart(line + 1))
+
+	if end > len(src) {
+		return false
+	}
+
+	return len(bytes.TrimSpace(src[start:end])) == 0
+}
+
+// startsLine returns true if pos is the first non-whitespace character on its
+// line in src.
+func startsLine(tf *token.File, src []byte, pos token.Pos) bool {
+	start := tf.Offset(tf.LineStart(tf.Line(pos)))
+	offset := tf.Offset(pos)
+
+	if offset > len(src) {
+		return false
+	}
+
+	return len(bytes.TrimSpace(src[start:offset])) == 0
+}
+
+// findOrphanedComments reports comment groups that end one blank line above an
+// exported element without a comment and start with the name of the element.
+// Such comments were most likely meant to be the comment of the element but
+// aren't attached to it because of the blank line. A fix removing the blank
+// line is suggested.
+//
+// Elements with orphaned comments aren't reported as missing a comment.
+func findOrphanedComments(r *reporter, leadWords leadWordSets) {
+	for _, f := range r.pass.Files {
+		tf := r.pass.Fset.File(f.Pos())
+		if tf == nil {
+			continue
+		}
+
+		candidates := orphanCandidates(tf, f, leadWords)
+		if len(candidates) == 0 {
+			continue
+		}
+
+		for _, group := range f.Comments {
+			endLine := tf.Line(group.End())
+
+			candidate, ok := candidates[endLine+2]
+			if !ok || len(group.Text()) == 0 {
+				continue
+			}
+
+			name, _ := r.words.commentedName(candidate.names, group)
+
+			words := commentWords(group)
+			if !r.words.startsWithName(words, name, candidate.leadWords) {
+				continue
+			}
+
+			// Only read the source once a comment that could be orphaned is found.
+			src, ok := r.source(tf)
+			if !ok {
+				break
+			}
+
+			// Comments trailing code on the same line aren't meant as a comment for
+			// the element below.
+			if !isBlankLine(tf, src, endLine+1) ||
+				!startsLine(tf, src, group.Pos()) {
+				continue
+			}
+
+			r.misplaced[candidate.pos] = struct{}{}
+			r.receiver = candidate.receiver
+
+			r.report(name, analysis.Diagnostic{
+				Pos:      group.Pos(),
+				End:      group.End(),
+				Category: ruleOrphaned,
+				Message:  fmt.Sprintf(orphanedCommentTmpl, name),
+				SuggestedFixes: []analysis.SuggestedFix{
+					{
+						Message: fmt.Sprintf(removeBlankLineFixTmpl, name),
+						TextEdits: []analysis.TextEdit{
+							{
+								Pos: tf.LineStart(endLine + 1),
+								End: tf.LineStart(endLine + 2),
+							},
+						},
+					},
+				},
+			})
+
+			r.receiver = ""
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	packageCommentMismatchTmpl = "package comment should start with " +
+		"'Package %s' instead of '%s'"
+	commandCommentMismatchTmpl = "command comment should start with " +
+		"'Command' or '%s' instead of '%s'"
+	packageCommentMissingTmpl  = "package '%s' should have a package comment"
+	packageCommentConflictTmpl = "package comment conflicts with the " +
+		"package comment in %s"
+
+	packageLeadWord = "Package"
+	commandLeadWord = "Command"
+	mainPackageName = "main"
+
+	rulePackageConflict = "package-conflict"
+)
+
+// packageDocFile is a non-test file of the package being analyzed.
+type packageDocFile struct {
+	name string
+	file *ast.File
+}
+
+// packageDocFiles returns the non-test files of the package being analyzed by
+// pass sorted by file name. Package comments in test files aren't shown by go
+// doc so they're skipped. Only _test.go files are skipped, not all files
+// matching the test file patterns, since go doc shows the comments in all other
+// files.
+func packageDocFiles(pass *analysis.Pass) []packageDocFile {
+	var res []packageDocFile
+
+	for _, f := range pass.Files {
+		tf := pass.Fset.File(f.Pos())
+		if tf == nil || strings.HasSuffix(tf.Name(), testFileNameSuffix) {
+			continue
+		}
+
+		res = append(res, packageDocFile{name: tf.Name(), file: f})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].name < res[j].name
+	})
+
+	return res
+}
+
+// checkPackageDocMismatch reports to r if the package comment of f doesn't
+// start with "Package <name>". Comments for package main describe a command
+// so they must start with "Command" or the name of the command, which is the
+// name of the directory the package is in.
+func checkPackageDocMismatch(
+	r *reporter,
+	name string,
+	dir string,
+	f *ast.File,
+) {
+	doc := f.Doc
+
+	if !checkCommentEmpty(r, name, doc, f.Package) {
+		return
+	}
+
+	words := commentWords(doc)
+
+	var (
+		first  string
+		second string
+	)
+
+	if len(words) > 0 {
+		first = words[0]
+	}
+
+	if len(words) > 1 {
+		second = words[1]
+	}
+
+	var msg string
+
+	if name == mainPackageName {
+		command := filepath.Base(dir)
+
+		normalized := r.words.normalize(first)
+		if normalized == commandLeadWord ||
+			strings.EqualFold(normalized, command) {
+			return
+		}
+
+		msg = fmt.Sprintf(commandCommentMismatchTmpl, command, first)
+	} else {
+		if first == packageLeadWord && r.words.normalize(second) == name {
+			return
+		}
+
+		msg = fmt.Sprintf(
+			packageCommentMismatchTmpl,
+			name,
+			strings.TrimSpace(first+" "+second),
+		)
+	}
+
+	r.report(name, analysis.Diagnostic{
+		Pos:      doc.Pos(),
+		End:      doc.End(),
+		Category: ruleMismatch,
+		Message:  msg,
+	})
+}
+
+// checkPackageDoc checks the package comments of the package being analyzed.
+// Each package comment must start with the name of the package. If the package
+// has more than one package comment, comments with different text than the
+// first one are reported since go doc joins them together. If requireComment
+// is set, packages other than main and external test packages must have a
+// package comment.
+func checkPackageDoc(r *reporter, requireComment bool) {
+	files := packageDocFiles(r.pass)
+	if len(files) == 0 {
+		return
+	}
+
+	name := r.pass.Pkg.Name()
+
+	var first *packageDocFile
+
+	for i := range files {
+		f := &files[i]
+
+		doc := f.file.Doc
+		if doc == nil {
+			continue
+		}
+
+		checkPackageDocMismatch(r, name, filepath.Dir(f.name), f.file)
+
+		// Docs with only directives, like go:generate, aren't package comments
+		// since go doc doesn't show them.
+		if len(strings.TrimSpace(doc.Text())) == 0 {
+			continue
+		}
+
+		if first == nil {
+			first = f
+			continue
+		}
+
+		if strings.TrimSpace(doc.Text()) ==
+			strings.TrimSpace(first.file.Doc.Text()) {
+			continue
+		}
+
+		r.report(name, analysis.Diagnostic{
+			Pos:      doc.Pos(),
+			End:      doc.End(),
+			Category: rulePackageConflict,
+			Message: fmt.Sprintf(
+				packageCommentConflictTmpl,
+				filepath.Base(first.name),
+			),
+		})
+	}
+
+	if first != nil || !requireComment || name == mainPackageName {
+		return
+	}
+
+	r.report(name, analysis.Diagnostic{
+		Pos:      files[0].file.Package,
+		Category: ruleMissing,
+		Message:  fmt.Sprintf(packageCommentMissingTmpl, name),
+	})
+}
@@ -0,0 +1,294 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	staleParamTmpl = "comment refers to '%s' which isn't a parameter or " +
+		"result of '%s'"
+
+	ruleParamName = "param-name"
+)
+
+var (
+	// backtickParamRegex matches identifiers in backticks, like `opts`.
+	backtickParamRegex = regexp.MustCompile("`([a-z_][A-Za-z0-9_]*)`")
+	// phraseParamRegex matches phrases referring to parameters by name, like
+	// "the ctx parameter" or "the opts argument".
+	phraseParamRegex = regexp.MustCompile(
+		`\b[Tt]he ([A-Za-z_][A-Za-z0-9_]*) ` +
+			`(?:parameter|param|argument|arg|result)s?\b`,
+	)
+)
+
+// signatureNames returns the names of the receiver, type parameters,
+// parameters, and results of a function. Blank names aren't included.
+func signatureNames(recv *ast.FieldList, typ *ast.FuncType) []string {
+	var res []string
+
+	for _, list := range []*ast.FieldList{
+		recv,
+		typ.TypeParams,
+		typ.Params,
+		typ.Results,
+	} {
+		if list == nil {
+			continue
+		}
+
+		for _, field := range list.List {
+			for _, ident := range field.Names {
+				if ident.Name != "_" {
+					res = append(res, ident.Name)
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// isAbbreviation returns true if short is an abbreviation of long, like opts
+// for options or idx for index. Both have to start with the same letter and
+// the letters of short have to appear in long in the same order.
+func isAbbreviation(short string, long string) bool {
+	if len(short) < 2 || len(short) >= len(long) {
+		return false
+	}
+
+	first, size := utf8.DecodeRuneInString(short)
+	if !strings.HasPrefix(long, string(first)) {
+		return false
+	}
+
+	rest := long[size:]
+
+	for _, r := range short[size:] {
+		idx := strings.IndexRune(rest, r)
+		if idx < 0 {
+			return false
+		}
+
+		rest = rest[idx+utf8.RuneLen(r):]
+	}
+
+	return true
+}
+
+// relatedParamNames returns the names in names word could be a misspelling,
+// abbreviation, or old version of. These are names within a small edit distance
+// of word or names word abbreviates or is abbreviated by.
+func relatedParamNames(word string, names []string) []string {
+	var res []string
+
+	for _, name := range names {
+		dist := editDistance(word, name)
+
+		if (dist <= maxSuggestionDistance && dist < len([]rune(word))) ||
+			isAbbreviation(word, name) ||
+			isAbbreviation(name, word) {
+			res = append(res, name)
+		}
+	}
+
+	return res
+}
+
+// looksLikeIdentifier returns true if word is shaped like an identifier in a
+// way ordinary words aren't. These words contain an underscore, a digit, or an
+// uppercase letter after the first letter, like max_len or maxLen.
+func looksLikeIdentifier(word string) bool {
+	for i, r := range word {
+		if r == '_' || unicode.IsDigit(r) || (i > 0 && unicode.IsUpper(r)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// closestParamName returns the name in names closest to word by edit distance.
+// Returns false if several names are equally close or if the closest name has
+// nothing in common with word.
+func closestParamName(word string, names []string) (string, bool) {
+	var (
+		best     string
+		bestDist = -1
+		unique   bool
+	)
+
+	for _, name := range names {
+		dist := editDistance(word, name)
+
+		switch {
+		case bestDist < 0 || dist < bestDist:
+			best = name
+			bestDist = dist
+			unique = true
+
+		case dist == bestDist:
+			unique = false
+		}
+	}
+
+	longest := len([]rune(word))
+	if n := len([]rune(best)); n > longest {
+		longest = n
+	}
+
+	if !unique || bestDist >= longest {
+		return "", false
+	}
+
+	return best, true
+}
+
+// paramReference is a word in a comment that looks like it refers to a
+// parameter along with its position.
+type paramReference struct {
+	word string
+	pos  token.Pos
+	// phrase is set if the word was found in a phrase like "the ctx parameter"
+	// instead of in backticks.
+	phrase bool
+}
+
+// paramReferences returns the words in comment that look like references to
+// parameters. These are lowercase identifiers in backticks and names in
+// phrases like "the ctx parameter".
+func paramReferences(comment *ast.CommentGroup) []paramReference {
+	var res []paramReference
+
+	seen := map[token.Pos]struct{}{}
+
+	for _, c := range comment.List {
+		for _, re := range []*regexp.Regexp{
+			backtickParamRegex,
+			phraseParamRegex,
+		} {
+			for _, match := range re.FindAllStringSubmatchIndex(c.Text, -1) {
+				pos := c.Pos() + token.Pos(match[2])
+				if _, ok := seen[pos]; ok {
+					continue
+				}
+
+				seen[pos] = struct{}{}
+
+				res = append(res, paramReference{
+					word:   c.Text[match[2]:match[3]],
+					pos:    pos,
+					phrase: re == phraseParamRegex,
+				})
+			}
+		}
+	}
+
+	return res
+}
+
+// checkParamNames reports to r words in the doc comment of the function name
+// that look like they refer to a parameter but aren't the name of a parameter,
+// result, type parameter, or the receiver of the function. These are usually
+// left over from renaming a parameter. Words that are the name of something
+// else in scope, like a type, package, or a field or method of the receiver,
+// aren't reported.
+//
+// Comments use backticks and phrases like "the first argument" for plenty of
+// things besides parameters, so a word is only reported if it's close to a
+// name in the signature. Words in phrases are reported as well if they're
+// shaped like identifiers, like maxLen. If a single name in the signature is
+// closest to the word a fix replacing the word with it is suggested.
+func checkParamNames(
+	r *reporter,
+	name *ast.Ident,
+	doc *ast.CommentGroup,
+	recv *ast.FieldList,
+	typ *ast.FuncType,
+) {
+	if doc == nil {
+		return
+	}
+
+	names := signatureNames(recv, typ)
+
+	known := map[string]struct{}{}
+	for _, n := range names {
+		known[n] = struct{}{}
+	}
+
+	scope := r.pass.Pkg.Scope().Innermost(name.Pos())
+	if scope == nil {
+		scope = r.pass.Pkg.Scope()
+	}
+
+	var recvType types.Type
+
+	if fun, ok := r.pass.TypesInfo.Defs[name].(*types.Func); ok {
+		if sig, ok := fun.Type().(*types.Signature); ok && sig.Recv() != nil {
+			recvType = sig.Recv().Type()
+		}
+	}
+
+	for _, ref := range paramReferences(doc) {
+		if _, ok := known[ref.word]; ok || ref.word == name.Name ||
+			token.IsKeyword(ref.word) {
+			continue
+		}
+
+		if _, obj := scope.LookupParent(ref.word, token.NoPos); obj != nil {
+			continue
+		}
+
+		if recvType != nil {
+			obj, _, _ := types.LookupFieldOrMethod(
+				recvType,
+				true,
+				r.pass.Pkg,
+				ref.word,
+			)
+			if obj != nil {
+				continue
+			}
+		}
+
+		related := relatedParamNames(ref.word, names)
+		if len(related) == 0 &&
+			(!ref.phrase || !looksLikeIdentifier(ref.word)) {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      ref.pos,
+			End:      ref.pos + token.Pos(len(ref.word)),
+			Category: ruleParamName,
+			Message:  fmt.Sprintf(staleParamTmpl, ref.word, name.Name),
+		}
+
+		if suggestion, ok := closestParamName(ref.word, related); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{
+				{
+					Message: fmt.Sprintf(replaceWordFixTmpl, ref.word, suggestion),
+					TextEdits: []analysis.TextEdit{
+						{
+							Pos:     diag.Pos,
+							End:     diag.End,
+							NewText: []byte(suggestion),
+						},
+					},
+				},
+			}
+		}
+
+		r.report(name.Name, diag)
+	}
+}
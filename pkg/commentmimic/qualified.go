@@ -0,0 +1,125 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	receiverMismatchTmpl = "first word of comment qualifies '%s' with '%s' " +
+		"instead of '%s'"
+
+	pointerQualifierPrefix = "(*"
+	pointerQualifierSuffix = ")"
+	typeArgsOpen           = "["
+	typeArgsClose          = "]"
+
+	ruleReceiver = "receiver"
+)
+
+// splitQualifiedName splits a receiver-qualified method name like T.Method,
+// (*T).Method, or T[K].Method into the name of the receiver type and the name
+// of the method. Type arguments aren't part of the returned receiver type name.
+// The returned offset is the byte offset of the receiver type name in word.
+// Returns false if word isn't qualified.
+func splitQualifiedName(word string) (string, string, int, bool) {
+	idx := strings.LastIndex(word, ".")
+	if idx <= 0 || idx == len(word)-1 {
+		return "", "", 0, false
+	}
+
+	qualifier := word[:idx]
+	method := word[idx+1:]
+	offset := 0
+
+	if strings.HasPrefix(qualifier, pointerQualifierPrefix) &&
+		strings.HasSuffix(qualifier, pointerQualifierSuffix) {
+		offset = len(pointerQualifierPrefix)
+		qualifier = qualifier[offset : len(qualifier)-len(pointerQualifierSuffix)]
+	}
+
+	// Type arguments of generic receivers aren't part of the type name.
+	if idx := strings.Index(qualifier, typeArgsOpen); idx > 0 &&
+		strings.HasSuffix(qualifier, typeArgsClose) {
+		qualifier = qualifier[:idx]
+	}
+
+	if len(qualifier) == 0 || strings.ContainsAny(qualifier, "().*[]") {
+		return "", "", 0, false
+	}
+
+	return qualifier, method, offset, true
+}
+
+// checkQualifiedName checks comments on methods that start with the name of the
+// method qualified by the name of its receiver type, like "Client.Do sends..."
+// or "(*Client).Do sends...". Returns true if the first word of comment is such
+// a name, in which case the comment shouldn't be checked for a mismatch. If the
+// qualifier isn't recvName it's reported to r along with a fix that replaces
+// the qualifier with recvName.
+//
+// Returns false if recvName is empty or the first word of comment isn't the
+// name of the method qualified by a type.
+func checkQualifiedName(
+	r *reporter,
+	recvName string,
+	elementName string,
+	comment *ast.CommentGroup,
+) bool {
+	if len(recvName) == 0 || comment == nil {
+		return false
+	}
+
+	words := commentWords(comment)
+	if len(words) == 0 {
+		return false
+	}
+
+	core, coreOffset := r.words.core(words[0])
+
+	qualifier, method, offset, ok := splitQualifiedName(core)
+	if !ok || method != elementName {
+		return false
+	}
+
+	if qualifier == recvName {
+		return true
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      comment.Pos(),
+		End:      comment.End(),
+		Category: ruleReceiver,
+		Message: fmt.Sprintf(
+			receiverMismatchTmpl,
+			elementName,
+			qualifier,
+			recvName,
+		),
+	}
+
+	if start, _, ok := commentWordPos(comment, 0); ok {
+		start += token.Pos(coreOffset + offset)
+
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf(replaceWordFixTmpl, qualifier, recvName),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     start,
+						End:     start + token.Pos(len(qualifier)),
+						NewText: []byte(recvName),
+					},
+				},
+			},
+		}
+	}
+
+	r.report(elementName, diag)
+
+	return true
+}
@@ -0,0 +1,152 @@
+package commentmimic
+
+// Rule describes a kind of finding reported by the analyzer. The ID of a rule
+// is used as the category of the diagnostics for it and doesn't change between
+// releases.
+type Rule struct {
+	ID          string
+	Name        string
+	Description string
+	Help        string
+}
+
+// Rules returns all the rules the analyzer reports findings for.
+func Rules() []Rule {
+	return []Rule{
+		{
+			ID:          ruleMismatch,
+			Name:        "CommentMismatch",
+			Description: "Comment doesn't start with the name of the element.",
+			Help: "Start the comment with the name of the element it's " +
+				"attached to. Struct comments may start with \"A\" or \"An\" " +
+				"followed by the name of the struct. Package comments start " +
+				"with \"Package\" followed by the name of the package, or with " +
+				"\"Command\" or the name of the command for package main.",
+		},
+		{
+			ID:          ruleEmpty,
+			Name:        "CommentEmpty",
+			Description: "Comment attached to the element is empty.",
+			Help: "Add text describing the element to the comment or remove " +
+				"the comment.",
+		},
+		{
+			ID:          ruleMissing,
+			Name:        "CommentMissing",
+			Description: "Exported element doesn't have a comment.",
+			Help: "Add a comment starting with the name of the element " +
+				"describing what it does.",
+		},
+		{
+			ID:          rulePackageConflict,
+			Name:        "PackageCommentConflict",
+			Description: "Package has different package comments in some files.",
+			Help: "Keep the package comment in a single file, usually doc.go, " +
+				"and remove it from the other files.",
+		},
+		{
+			ID:          ruleAliasTarget,
+			Name:        "AliasTarget",
+			Description: "Alias comment doesn't refer to the aliased type.",
+			Help: "Mention the aliased type, like [pkg.Type], in the comment " +
+				"or add a paragraph starting with \"Deprecated: \" saying what " +
+				"to use instead.",
+		},
+		{
+			ID:   ruleBlockConflict,
+			Name: "TypeBlockConflict",
+			Description: "Comment on a type block contradicts the comment on " +
+				"the only type in the block.",
+			Help: "Remove the comment on the type block or make it start " +
+				"with the name of the type.",
+		},
+		{
+			ID:   ruleOrphaned,
+			Name: "OrphanedComment",
+			Description: "Comment for an element is separated from it by a " +
+				"blank line.",
+			Help: "Remove the blank line between the comment and the element " +
+				"so the comment is attached to the element.",
+		},
+		{
+			ID:   ruleTrailing,
+			Name: "TrailingComment",
+			Description: "Comment for an element is at the end of its line " +
+				"instead of above it.",
+			Help: "Move the comment to the line above the element so it's " +
+				"used as the doc comment of the element.",
+		},
+		{
+			ID:   ruleReceiver,
+			Name: "ReceiverMismatch",
+			Description: "Comment on a method starts with the method name " +
+				"qualified by a type other than its receiver.",
+			Help: "Qualify the method name with the receiver type of the method.",
+		},
+		{
+			ID:   ruleArticle,
+			Name: "ArticleMismatch",
+			Description: "Comment starts with \"A\" or \"An\" but the article " +
+				"doesn't agree with the sound the element name starts with.",
+			Help: "Use \"An\" before names starting with a vowel sound and " +
+				"\"A\" before other names.",
+		},
+		{
+			ID:   ruleDocLink,
+			Name: "BrokenDocLink",
+			Description: "Doc link in a comment doesn't refer to an existing " +
+				"identifier.",
+			Help: "Update the doc link to refer to the renamed identifier or " +
+				"remove the brackets.",
+		},
+		{
+			ID:   ruleParamName,
+			Name: "StaleParameterName",
+			Description: "Function comment refers to a parameter that isn't in " +
+				"the signature of the function.",
+			Help: "Update the comment to use the current name of the parameter.",
+		},
+		{
+			ID:   ruleBoolVerb,
+			Name: "BoolVerb",
+			Description: "Comment on a function returning a bool doesn't say " +
+				"what it reports.",
+			Help: "Start the comment with the function name followed by " +
+				"\"reports whether\".",
+		},
+		{
+			ID:   ruleConstructorDoc,
+			Name: "ConstructorDoc",
+			Description: "Comment on a constructor doesn't mention the type it " +
+				"returns.",
+			Help: "Mention the returned type, like \"NewFoo returns a new Foo\".",
+		},
+		{
+			ID:   ruleErrorDoc,
+			Name: "ErrorDoc",
+			Description: "Comment on a function returning an error doesn't " +
+				"describe when it fails.",
+			Help: "Describe the cases the function returns an error in.",
+		},
+		{
+			ID:          ruleUnusedIgnore,
+			Name:        "UnusedIgnore",
+			Description: "Suppression directive doesn't suppress any findings.",
+			Help:        "Remove the commentmimic:ignore directive.",
+		},
+		{
+			ID:          ruleIgnoreReason,
+			Name:        "IgnoreReason",
+			Description: "Suppression directive is missing a reason.",
+			Help: "Add the reason the findings are suppressed after the " +
+				"commentmimic:ignore directive and the rules it suppresses.",
+		},
+		{
+			ID:          ruleStaleBaseline,
+			Name:        "StaleBaseline",
+			Description: "Baseline entry has more findings than were found.",
+			Help: "Write the baseline again to remove findings that were " +
+				"fixed.",
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package testdata
+
+const (
+	Aliases = `package a
+
+import "net/http"
+
+type generic[K comparable, V any] map[K]V
+
+// Client is an alias for [http.Client].
+type Client = http.Client
+
+// This Transport has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'Transport'" "comment on alias 'Transport' should refer to 'http.Tran[s]port'"
+type Transport = http.Transport
+
+// A Request has a correctly formatted comment with a lead word but doesn't refer to its target. // want "comment on alias 'Request' should refer to 'http.Req[u]est'"
+type Request = http.Request
+
+// Response has a correctly formatted comment.
+//
+// Deprecated: use http.Response instead.
+type Response = http.Response
+
+// Header is an alias for http.Header. Deprecated: doesn't count as a
+// paragraph but the target is mentioned.
+type Header = http.Header
+
+// Cookie has a correctly formatted comment. // want "comment on alias 'Cookie' should refer to 'http.Cook[i]e'"
+//
+// The paragraph isn't at the start of the line. Deprecated: use something else.
+type Cookie = http.Cookie
+
+// Pairs is a mapping of generics. // want "comment on alias 'Pairs' should refer to 'gen[e]ric'"
+type Pairs = generic[int, int]
+
+// StringMap is an alias for generic.
+type StringMap = generic[string, string]
+
+type (
+  // Struct is an alias for a struct. // want "comment on alias 'Struct' should refer to 'struct.}'"
+  Struct = struct{}
+
+  // This Interface has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'Interface'" "comment on alias 'Interface' should refer to 'interface.}'"
+  Interface = interface{}
+
+  Missing = int // want "exported element 'Missing' should be commented"
+
+  //
+  Empty = int // want "empty comment on 'Empty'"
+
+  // unexported doesn't need to refer to its target.
+  unexported = int
+
+  notRequired = int
+)
+`
+)
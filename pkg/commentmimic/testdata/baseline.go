@@ -0,0 +1,69 @@
+package testdata
+
+const (
+	BaselineLegacy = `package a
+
+func Missing() bool {
+  return false
+}
+
+// This function has a comment.
+func Mismatch() bool {
+  return false
+}
+
+type t struct{}
+
+func (t) Close() {}
+
+type u struct{}
+
+func (u) Close() {}
+
+func Fixed() {}
+`
+
+	BaselineLegacyEntries = `{
+  "entries": [
+    {"package": "a", "file": "a.go", "element": "Fixed", "rule": "missing", "count": 1},
+    {"package": "a", "file": "a.go", "element": "Mismatch", "rule": "mismatch", "count": 1},
+    {"package": "a", "file": "a.go", "element": "Missing", "rule": "missing", "count": 1},
+    {"package": "a", "file": "a.go", "receiver": "t", "element": "Close", "rule": "missing", "count": 1},
+    {"package": "a", "file": "a.go", "receiver": "u", "element": "Close", "rule": "missing", "count": 1}
+  ]
+}`
+
+	// BaselineUpdated has the findings from BaselineLegacy moved around, one of
+	// them fixed, and some new findings.
+	BaselineUpdated = `package a // want "stale baseline entry for 'Fixed' \\(missing\\): found 0 of 1 findings in a.go" "stale baseline entry for 'u.Close' \\(missing\\): found 0 of 1 findings in a.go"
+
+func New() bool { // want "exported element 'New' should be commented"
+  return false
+}
+
+type t struct{}
+
+func (t) Close() {}
+
+// This function has a comment.
+func Mismatch() bool {
+  return false
+}
+
+func Missing() bool {
+  return false
+}
+
+type u struct{}
+
+// Close has a comment now.
+func (u) Close() {}
+
+type v struct{}
+
+func (v) Close() {} // want "exported element 'Close' should be commented"
+
+// Fixed has a comment now.
+func Fixed() {}
+`
+)
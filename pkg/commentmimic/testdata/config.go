@@ -0,0 +1,96 @@
+package testdata
+
+const (
+	ConfigYAML = `comment-all-exported: true
+overrides:
+  - paths: ["a/internal"]
+    comment-all-exported: false
+  - paths: ["a/internal/*"]
+    comment-structs: true
+  - paths: ["a/internal/c"]
+    comment-all-exported: true
+`
+
+	ConfigJSON = `{
+  "comment-all-exported": true,
+  "overrides": [
+    {"paths": ["a/internal"], "comment-all-exported": false},
+    {"paths": ["a/internal/*"], "comment-structs": true},
+    {"paths": ["a/internal/c"], "comment-all-exported": true}
+  ]
+}
+`
+
+	ConfigTopLevel = `package a
+
+func Exported() bool { // want "exported element 'Exported' should be commented"
+  return false
+}
+
+type Struct struct {}
+`
+
+	// ConfigOverride is in a/internal/b which matches both the a/internal and
+	// a/internal/* overrides. Both overrides are applied, starting with the less
+	// specific a/internal override.
+	ConfigOverride = `package b
+
+func Exported() bool {
+  return false
+}
+
+type Struct struct {} // want "exported element 'Struct' should be commented"
+`
+
+	// ConfigNestedOverride is in a/internal/c which matches all overrides. The
+	// a/internal/c override is as specific as a/internal/* and comes later in the
+	// file so it's applied last.
+	ConfigNestedOverride = `package c
+
+func Exported() bool { // want "exported element 'Exported' should be commented"
+  return false
+}
+
+type Struct struct {} // want "exported element 'Struct' should be commented"
+`
+
+	// ConfigParentOverride is in a/internal/d/e which matches the a/internal and
+	// a/internal/* overrides through its parent directories.
+	ConfigParentOverride = `package e
+
+func Exported() bool {
+  return false
+}
+
+type Struct struct {} // want "exported element 'Struct' should be commented"
+`
+
+	// ConfigNoOverride is in b which doesn't match any overrides.
+	ConfigNoOverride = `package b
+
+func Exported() bool { // want "exported element 'Exported' should be commented"
+  return false
+}
+
+type Struct struct {}
+`
+
+	// ConfigFlagPrecedence is analyzed with comment-all-exported set to true
+	// and comment-structs set to false on the command line, which take
+	// precedence over the config file.
+	ConfigFlagPrecedenceYAML = `comment-all-exported: false
+comment-structs: true
+comment-interfaces: true
+`
+
+	ConfigFlagPrecedence = `package a
+
+func Exported() bool { // want "exported element 'Exported' should be commented"
+  return false
+}
+
+type Struct struct {}
+
+type Interface interface {} // want "exported element 'Interface' should be commented"
+`
+)
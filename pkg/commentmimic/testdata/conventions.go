@@ -0,0 +1,111 @@
+package testdata
+
+const (
+	Conventions = `package a
+
+import "errors"
+
+type Client struct{}
+
+type Flag bool
+
+// IsValid reports whether the client is valid.
+func (c *Client) IsValid() bool { return true }
+
+// IsEmpty returns whether the client is empty. // want "comment on 'IsEmpty' should use 'reports' instead of 'returns' since it returns a bool"
+func (c *Client) IsEmpty() bool { return true }
+
+// IsOpen returns true if the client is open. // want "comment on 'IsOpen' should use 'reports' instead of 'returns' since it returns a bool"
+func (c *Client) IsOpen() bool { return true }
+
+// Enabled returns the flag of the client.
+func (c *Client) Enabled() Flag { return true }
+
+// Lookup returns the value and whether it was found.
+func Lookup() (int, bool) { return 0, false }
+
+// NewClient returns a new [Client].
+func NewClient() *Client { return nil }
+
+// NewClientWithOptions returns a new client with options. // want "comment on constructor 'NewClientWithOptions' should mention the type 'Cl[i]ent' it returns"
+func NewClientWithOptions() (*Client, error) { return nil, nil }
+
+// New returns a Client's zero value.
+func New() Client { return Client{} }
+
+// NewFlag returns a new value. // want "comment on constructor 'NewFlag' should mention the type 'F[l]ag' it returns"
+func NewFlag() Flag { return false }
+
+// Newest returns the newest value.
+func Newest() int { return 0 }
+
+// Close closes the client and returns an error if it's already closed.
+func (c *Client) Close() error { return nil }
+
+// Flush fails if there's nothing to flush.
+func (c *Client) Flush() error { return nil }
+
+// Open opens the client. // want "comment on 'Open' should describe when it returns an e[r]ror"
+func (c *Client) Open() error { return errors.New("open") }
+
+// Read reads from the client.
+func (c *Client) Read() (int, error) { return 0, nil }
+
+// This comment doesn't start with the name so it's not checked. // want "first word of comment is 'This' instead of 'Write'"
+func (c *Client) Write() error { return nil }
+`
+
+	ConventionsGolden = `package a
+
+import "errors"
+
+type Client struct{}
+
+type Flag bool
+
+// IsValid reports whether the client is valid.
+func (c *Client) IsValid() bool { return true }
+
+// IsEmpty reports whether the client is empty. // want "comment on 'IsEmpty' should use 'reports' instead of 'returns' since it returns a bool"
+func (c *Client) IsEmpty() bool { return true }
+
+// IsOpen returns true if the client is open. // want "comment on 'IsOpen' should use 'reports' instead of 'returns' since it returns a bool"
+func (c *Client) IsOpen() bool { return true }
+
+// Enabled returns the flag of the client.
+func (c *Client) Enabled() Flag { return true }
+
+// Lookup returns the value and whether it was found.
+func Lookup() (int, bool) { return 0, false }
+
+// NewClient returns a new [Client].
+func NewClient() *Client { return nil }
+
+// NewClientWithOptions returns a new client with options. // want "comment on constructor 'NewClientWithOptions' should mention the type 'Cl[i]ent' it returns"
+func NewClientWithOptions() (*Client, error) { return nil, nil }
+
+// New returns a Client's zero value.
+func New() Client { return Client{} }
+
+// NewFlag returns a new value. // want "comment on constructor 'NewFlag' should mention the type 'F[l]ag' it returns"
+func NewFlag() Flag { return false }
+
+// Newest returns the newest value.
+func Newest() int { return 0 }
+
+// Close closes the client and returns an error if it's already closed.
+func (c *Client) Close() error { return nil }
+
+// Flush fails if there's nothing to flush.
+func (c *Client) Flush() error { return nil }
+
+// Open opens the client. // want "comment on 'Open' should describe when it returns an e[r]ror"
+func (c *Client) Open() error { return errors.New("open") }
+
+// Read reads from the client.
+func (c *Client) Read() (int, error) { return 0, nil }
+
+// Write comment doesn't start with the name so it's not checked. // want "first word of comment is 'This' instead of 'Write'"
+func (c *Client) Write() error { return nil }
+`
+)
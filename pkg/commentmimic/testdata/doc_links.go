@@ -0,0 +1,105 @@
+package testdata
+
+const (
+	DocLinks = `package a
+
+import (
+  "net/http"
+  str "strings"
+)
+
+// Client sends requests with [http.Client] and builds them with
+// [str.Builder].
+type Client struct {
+  // Timeout is passed to [http.Client.Timeout].
+  Timeout int
+
+  client  *http.Client
+  builder str.Builder
+}
+
+// Do sends a request. See [Client], [*Client], [Client.Timeout], and
+// [Client.Do]. Errors are returned as an [error].
+func (c *Client) Do() {}
+
+// Get is like [Client.Dp]. // want "doc link '\\[Client.Dp\\]' doesn't refer to an existing identifier"
+func Get() {}
+
+// Post is like [Clinet.Do]. // want "doc link '\\[Clinet.Do\\]' doesn't refer to an existing identifier"
+func Post() {}
+
+// Head is like [Gte]. // want "doc link '\\[Gte\\]' doesn't refer to an existing identifier"
+func Head() {}
+
+// Put is like [Unrelated]. // want "doc link '\\[Unrelated\\]' doesn't refer to an existing identifier"
+func Put() {}
+
+// Patch uses [http.Clinet]. // want "doc link '\\[http.Clinet\\]' doesn't refer to an existing identifier"
+func Patch() {}
+
+// Delete uses [a.Gte] and [a.Get]. // want "doc link '\\[a.Gte\\]' doesn't refer to an existing identifier"
+func Delete() {}
+
+// Options uses [io.Reader], [math/rand], and [os], which aren't imported.
+func Options() {}
+
+// Trace mentions [brackets] and x[Y] that aren't doc links.
+func Trace() {}
+
+// Connect is like [Gte] and [Put]. // want "doc link '\\[Gte\\]' doesn't refer to an existing identifier"
+//
+//   [Missing] in a code block isn't a doc link.
+func Connect() {}
+`
+
+	DocLinksGolden = `package a
+
+import (
+  "net/http"
+  str "strings"
+)
+
+// Client sends requests with [http.Client] and builds them with
+// [str.Builder].
+type Client struct {
+  // Timeout is passed to [http.Client.Timeout].
+  Timeout int
+
+  client  *http.Client
+  builder str.Builder
+}
+
+// Do sends a request. See [Client], [*Client], [Client.Timeout], and
+// [Client.Do]. Errors are returned as an [error].
+func (c *Client) Do() {}
+
+// Get is like [Client.Do]. // want "doc link '\\[Client.Dp\\]' doesn't refer to an existing identifier"
+func Get() {}
+
+// Post is like [Client.Do]. // want "doc link '\\[Clinet.Do\\]' doesn't refer to an existing identifier"
+func Post() {}
+
+// Head is like [Get]. // want "doc link '\\[Gte\\]' doesn't refer to an existing identifier"
+func Head() {}
+
+// Put is like [Unrelated]. // want "doc link '\\[Unrelated\\]' doesn't refer to an existing identifier"
+func Put() {}
+
+// Patch uses [http.Client]. // want "doc link '\\[http.Clinet\\]' doesn't refer to an existing identifier"
+func Patch() {}
+
+// Delete uses [a.Get] and [a.Get]. // want "doc link '\\[a.Gte\\]' doesn't refer to an existing identifier"
+func Delete() {}
+
+// Options uses [io.Reader], [math/rand], and [os], which aren't imported.
+func Options() {}
+
+// Trace mentions [brackets] and x[Y] that aren't doc links.
+func Trace() {}
+
+// Connect is like [Get] and [Put]. // want "doc link '\\[Gte\\]' doesn't refer to an existing identifier"
+//
+//   [Missing] in a code block isn't a doc link.
+func Connect() {}
+`
+)
@@ -0,0 +1,131 @@
+package testdata
+
+const (
+	MismatchFixes = `package a
+
+// elementA has a comment. // want "first word of comment is 'elementA' instead of 'ElementA'"
+func ElementA() bool {
+  return false
+}
+
+/* This has a comment. */ // want "first word of comment is 'This' instead of 'elementB'"
+func elementB() bool {
+  return false
+}
+
+/*
+	oldName has a comment. // want "first word of comment is 'oldName' instead of 'elementC'"
+*/
+func elementC() bool {
+  return false
+}
+
+//nolint:commentmimic // want "first word of comment is 'oldName' instead of 'ElementD'"
+// oldName has a comment.
+type ElementD interface {
+  // oldName has a comment. // want "first word of comment is 'oldName' instead of 'ElementE'"
+  ElementE() bool
+}
+
+// A oldName has a comment. // want "first word of comment is 'A' instead of 'ElementF'"
+type ElementF struct {}
+`
+
+	MismatchFixesGolden = `package a
+
+// ElementA has a comment. // want "first word of comment is 'elementA' instead of 'ElementA'"
+func ElementA() bool {
+  return false
+}
+
+/* elementB has a comment. */ // want "first word of comment is 'This' instead of 'elementB'"
+func elementB() bool {
+  return false
+}
+
+/*
+	elementC has a comment. // want "first word of comment is 'oldName' instead of 'elementC'"
+*/
+func elementC() bool {
+  return false
+}
+
+//nolint:commentmimic // want "first word of comment is 'oldName' instead of 'ElementD'"
+// ElementD has a comment.
+type ElementD interface {
+  // ElementE has a comment. // want "first word of comment is 'oldName' instead of 'ElementE'"
+  ElementE() bool
+}
+
+// A ElementF has a comment. // want "first word of comment is 'A' instead of 'ElementF'"
+type ElementF struct {}
+`
+
+	StubCommentFixes = `package a
+
+func FreeFunc() bool { // want "exported element 'FreeFunc' should be commented"
+  return false
+}
+
+type testStruct struct {}
+
+func (t *testStruct) PtrReceiver() bool { // want "exported element 'PtrReceiver' should be commented"
+  return false
+}
+
+//nolint:commentmimic
+type Iface interface { // want "exported element 'Iface' should be commented"
+	IfaceFunc() bool // want "exported element 'IfaceFunc' should be commented"
+}
+
+type (
+  // Commented has a comment.
+  Commented struct {}
+
+  NotCommented struct {} // want "exported element 'NotCommented' should be commented"
+)
+
+const (
+	BlockConst = 43 // want "exported element 'BlockConst' should be commented"
+)
+
+type ( Inline struct {} ) // want "exported element 'Inline' should be commented"
+`
+
+	StubCommentFixesGolden = `package a
+
+// FreeFunc ...
+func FreeFunc() bool { // want "exported element 'FreeFunc' should be commented"
+  return false
+}
+
+type testStruct struct {}
+
+// PtrReceiver ...
+func (t *testStruct) PtrReceiver() bool { // want "exported element 'PtrReceiver' should be commented"
+  return false
+}
+
+//nolint:commentmimic
+// Iface ...
+type Iface interface { // want "exported element 'Iface' should be commented"
+	// IfaceFunc ...
+	IfaceFunc() bool // want "exported element 'IfaceFunc' should be commented"
+}
+
+type (
+  // Commented has a comment.
+  Commented struct {}
+
+  // NotCommented ...
+  NotCommented struct {} // want "exported element 'NotCommented' should be commented"
+)
+
+const (
+	// BlockConst ...
+	BlockConst = 43 // want "exported element 'BlockConst' should be commented"
+)
+
+type ( Inline struct {} ) // want "exported element 'Inline' should be commented"
+`
+)
@@ -0,0 +1,63 @@
+package testdata
+
+const (
+	GoDocMode = `package a
+
+// someStruct is a struct used for passing data.
+type (
+  someStruct struct{}
+)
+
+// A ExportedStruct is a struct used for passing data.
+type (
+  ExportedStruct struct{}
+)
+
+// Types that are grouped together.
+type (
+  GroupedA struct{}
+  GroupedB struct{}
+
+  // GroupedC has its own comment.
+  GroupedC struct{}
+)
+
+// ExportedInterface is used for grouped types.
+type (
+  ExportedInterface interface{}
+
+  // This otherInterface has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'otherInterface'"
+  otherInterface interface{}
+)
+
+type (
+  MissingStruct struct{} // want "exported element 'MissingStruct' should be commented"
+)
+
+// Consts used for grouped values.
+const (
+  ConstA = 1
+  ConstB = 2
+)
+
+const (
+  ConstC = 3 // want "exported element 'ConstC' should be commented"
+)
+
+// NewExportedStruct is listed under ExportedStruct by go/doc.
+func NewExportedStruct() ExportedStruct {
+  return ExportedStruct{}
+}
+
+// Method is listed under ExportedStruct by go/doc.
+func (ExportedStruct) Method() {}
+`
+
+	GoDocModeTest = `package a
+
+// Types in test files aren't part of the package go/doc shows.
+type (
+  TestStruct struct{} // want "exported element 'TestStruct' should be commented"
+)
+`
+)
@@ -0,0 +1,72 @@
+package testdata
+
+const (
+	IgnoreDirectives = `package a
+
+//commentmimic:ignore mismatch kept for compatibility with old docs
+// This function has a comment.
+func IgnoreMismatch() bool {
+  return false
+}
+
+// This function has a comment.
+//commentmimic:ignore kept for compatibility with old docs
+func IgnoreAll() bool {
+  return false
+}
+
+//commentmimic:ignore missing,mismatch generated code
+func IgnoreMissing() bool {
+  return false
+}
+
+// This function has a comment. // want "first word of comment is 'This' instead of 'IgnoreWrongRule'"
+//commentmimic:ignore missing only the missing rule is ignored // want "unused commentmimic:ignore directive"
+func IgnoreWrongRule() bool {
+  return false
+}
+
+//commentmimic:ignore the comment is fine now // want "unused commentmimic:ignore directive"
+// UnusedIgnore has a comment.
+func UnusedIgnore() bool {
+  return false
+}
+
+//commentmimic:ignore not attached to the function // want "unused commentmimic:ignore directive"
+
+func NotAttached() bool { // want "exported element 'NotAttached' should be commented"
+  return false
+}
+
+type Iface interface {
+  //commentmimic:ignore missing interface functions can be ignored too
+  IfaceFunc() bool
+
+  OtherIfaceFunc() bool // want "exported element 'OtherIfaceFunc' should be commented"
+}
+`
+
+	IgnoreFileDirective = `package a
+
+//commentmimic:ignore-file missing legacy code
+
+func Missing() bool {
+  return false
+}
+
+// This function has a comment. // want "first word of comment is 'This' instead of 'Mismatch'"
+func Mismatch() bool {
+  return false
+}
+`
+
+	IgnoreFileDirectiveUnused = `package a
+
+//commentmimic:ignore-file nothing to ignore // want "unused commentmimic:ignore-file directive"
+
+// Commented has a comment.
+func Commented() bool {
+  return false
+}
+`
+)
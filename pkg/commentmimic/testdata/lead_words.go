@@ -0,0 +1,83 @@
+package testdata
+
+const (
+	LeadWordsConfig = `comment-structs: true
+comment-interfaces: true
+check-articles: true
+struct-lead-words: [The]
+interface-lead-words: [A, An]
+func-lead-words: []
+`
+
+	LeadWords = `package a
+
+// The Server has a comment with a custom lead word.
+type Server struct{}
+
+// A Client has a lead word that isn't allowed on structs. // want "first word of comment is 'A' instead of 'Client'"
+type Client struct{}
+
+// An Iterator has a comment with a lead word.
+type Iterator interface{}
+
+// A Reader has a comment with a lead word.
+type Reader interface{}
+
+// A Object has the wrong article. // want "comment should start with 'An' instead of 'A' before 'Object'"
+type Object interface{}
+
+// An User has the wrong article. // want "comment should start with 'A' instead of 'An' before 'User'"
+type User interface{}
+
+// A HTTPHandler has the wrong article. // want "comment should start with 'An' instead of 'A' before 'HTTPHandler'"
+type HTTPHandler interface{}
+
+// A URLParser has a comment with a lead word.
+type URLParser interface{}
+
+// An Hour has a comment with a lead word.
+type Hour interface{}
+
+// A State has a comment with the default lead words for named types.
+type State int
+
+// The Run has a lead word that isn't allowed on functions. // want "first word of comment is 'The' instead of 'Run'"
+func Run() {}
+`
+
+	LeadWordsGolden = `package a
+
+// The Server has a comment with a custom lead word.
+type Server struct{}
+
+// Client Client has a lead word that isn't allowed on structs. // want "first word of comment is 'A' instead of 'Client'"
+type Client struct{}
+
+// An Iterator has a comment with a lead word.
+type Iterator interface{}
+
+// A Reader has a comment with a lead word.
+type Reader interface{}
+
+// An Object has the wrong article. // want "comment should start with 'An' instead of 'A' before 'Object'"
+type Object interface{}
+
+// A User has the wrong article. // want "comment should start with 'A' instead of 'An' before 'User'"
+type User interface{}
+
+// An HTTPHandler has the wrong article. // want "comment should start with 'An' instead of 'A' before 'HTTPHandler'"
+type HTTPHandler interface{}
+
+// A URLParser has a comment with a lead word.
+type URLParser interface{}
+
+// An Hour has a comment with a lead word.
+type Hour interface{}
+
+// A State has a comment with the default lead words for named types.
+type State int
+
+// Run Run has a lead word that isn't allowed on functions. // want "first word of comment is 'The' instead of 'Run'"
+func Run() {}
+`
+)
@@ -0,0 +1,69 @@
+package testdata
+
+const (
+	LongFuncs = `package a
+
+func short() int {
+  return 1
+}
+
+func long() int { // want "unexported element 'long' should be commented \\(longer than 5 lines\\)"
+  a := 1
+  b := 2
+  c := 3
+  d := 4
+
+  return a + b + c + d
+}
+
+func Long() int { // want "exported element 'Long' should be commented \\(longer than 5 lines\\)"
+  a := 1
+  b := 2
+  c := 3
+  d := 4
+
+  return a + b + c + d
+}
+
+// documented has a comment so it isn't reported.
+func documented() int {
+  a := 1
+  b := 2
+  c := 3
+  d := 4
+
+  return a + b + c + d
+}
+
+func simple(a int) int {
+  if a > 0 {
+    return a
+  }
+
+  return 0
+}
+
+func complex(a int, b bool) int { // want "unexported element 'complex' should be commented \\(cyclomatic complexity over 2\\)"
+  if a > 0 && b {
+    return a
+  }
+
+  return 0
+}
+
+type thing struct{}
+
+func (t thing) complexMethod(a []int) int { // want "unexported element 'complexMethod' should be commented \\(longer than 5 lines\\)"
+  for _, v := range a {
+    switch v {
+    case 1:
+      return v
+
+    default:
+    }
+  }
+
+  return 0
+}
+`
+)
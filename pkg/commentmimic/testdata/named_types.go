@@ -0,0 +1,55 @@
+package testdata
+
+const (
+	NamedTypes = `package a
+
+import "net/http"
+
+/*
+Type equivalences.
+*/
+
+type (
+  // blockEquivalenceUnexportedCorrectComment has a correctly formatted comment.
+  blockEquivalenceUnexportedCorrectComment int
+
+  // This blockEquivalenceUnexportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'blockEquivalenceUnexportedWrongComment'"
+  blockEquivalenceUnexportedWrongComment int
+
+  // BlockEquivalenceExportedCorrectComment has a correctly formatted comment.
+  BlockEquivalenceExportedCorrectComment int
+
+  // This BlockEquivalenceExportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'BlockEquivalenceExportedWrongComment'"
+  BlockEquivalenceExportedWrongComment int
+)
+
+/*
+Other named types.
+*/
+
+// HandlerFunc has a correctly formatted comment.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request)
+
+// A State has a correctly formatted comment with a lead word.
+type State int
+
+// An Headers has a correctly formatted comment with a lead word.
+type Headers map[string]string
+
+// This Names has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'Names'"
+type Names []string
+
+// A Results has an incorrectly formatted comment. // want "first word of comment is 'A' instead of 'results'"
+type results chan int
+
+// Server has a correctly formatted comment.
+type Server http.Server
+
+//
+type Empty *int // want "empty comment on 'Empty'"
+
+type Missing [4]byte // want "exported element 'Missing' should be commented"
+
+type notRequired int
+`
+)
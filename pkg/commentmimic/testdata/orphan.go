@@ -0,0 +1,88 @@
+package testdata
+
+const (
+	OrphanedComments = `package a
+
+// FuncA has a comment separated by a blank line. // want "comment for 'FuncA' is separated from it by a blank line"
+
+func FuncA() {}
+
+// A TypeA has a comment separated by a blank line. // want "comment for 'TypeA' is separated from it by a blank line"
+
+type TypeA struct{}
+
+// ConstB and ConstA have a comment separated by a blank line. // want "comment for 'ConstB' is separated from it by a blank line"
+
+const ConstA, ConstB = 1, 2
+
+var (
+  // VarA has a comment separated by a blank line. // want "comment for 'VarA' is separated from it by a blank line"
+
+  VarA = 1
+)
+
+// Comments that don't start with the element name aren't orphaned.
+
+func FuncB() {} // want "exported element 'FuncB' should be commented"
+
+// FuncC has a comment separated by two blank lines.
+
+
+func FuncC() {} // want "exported element 'FuncC' should be commented"
+
+// funcD isn't exported.
+
+func funcD() {}
+
+// FuncE has its own comment.
+
+// FuncE has a comment.
+func FuncE() {}
+
+var varA = 1 // FuncF is a trailing comment.
+
+func FuncF() {} // want "exported element 'FuncF' should be commented"
+`
+
+	OrphanedCommentsGolden = `package a
+
+// FuncA has a comment separated by a blank line. // want "comment for 'FuncA' is separated from it by a blank line"
+func FuncA() {}
+
+// A TypeA has a comment separated by a blank line. // want "comment for 'TypeA' is separated from it by a blank line"
+type TypeA struct{}
+
+// ConstB and ConstA have a comment separated by a blank line. // want "comment for 'ConstB' is separated from it by a blank line"
+const ConstA, ConstB = 1, 2
+
+var (
+  // VarA has a comment separated by a blank line. // want "comment for 'VarA' is separated from it by a blank line"
+  VarA = 1
+)
+
+// Comments that don't start with the element name aren't orphaned.
+
+// FuncB ...
+func FuncB() {} // want "exported element 'FuncB' should be commented"
+
+// FuncC has a comment separated by two blank lines.
+
+
+// FuncC ...
+func FuncC() {} // want "exported element 'FuncC' should be commented"
+
+// funcD isn't exported.
+
+func funcD() {}
+
+// FuncE has its own comment.
+
+// FuncE has a comment.
+func FuncE() {}
+
+var varA = 1 // FuncF is a trailing comment.
+
+// FuncF ...
+func FuncF() {} // want "exported element 'FuncF' should be commented"
+`
+)
@@ -1,73 +1,61 @@
 package testdata
 
 const (
-	OutOfScopePatterns = `package a
-
-/*
-Var declarations -- should be ignored in all cases.
-*/
+	ValueFirstWord = `package a
 
 // varUnexportedCorrectComment has a correctly formatted comment.
 var varUnexportedCorrectComment = 43
-// This varUnexportedWrongComment has an incorrectly formatted comment.
+// This varUnexportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'varUnexportedWrongComment'"
 var varUnexportedWrongComment = 43
 // VarExportedCorrectComment has a correctly formatted comment.
 var VarExportedCorrectComment = 43
-// This VarExportedWrongComment has an incorrectly formatted comment.
+// This VarExportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'VarExportedWrongComment'"
 var VarExportedWrongComment = 43
 
 var (
   // blockVarUnexportedCorrectComment has a correctly formatted comment.
   blockVarUnexportedCorrectComment = 43
-  // This blockVarUnexportedWrongComment has an incorrectly formatted comment.
+  // This blockVarUnexportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'blockVarUnexportedWrongComment'"
   blockVarUnexportedWrongComment = 43
   // BlockVarExportedCorrectComment has a correctly formatted comment.
   BlockVarExportedCorrectComment = 43
-  // This BlockVarExportedWrongComment has an incorrectly formatted comment.
+  // This BlockVarExportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'BlockVarExportedWrongComment'"
   BlockVarExportedWrongComment = 43
 )
 
-/*
-Const declarations -- should be ignored in all cases.
-*/
-
 // constUnexportedCorrectComment has a correctly formatted comment.
 const constUnexportedCorrectComment = 43
-// This constUnexportedWrongComment has an incorrectly formatted comment.
+// This constUnexportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'constUnexportedWrongComment'"
 const constUnexportedWrongComment = 43
 // ConstExportedCorrectComment has a correctly formatted comment.
 const ConstExportedCorrectComment = 43
-// This ConstExportedWrongComment has an incorrectly formatted comment.
+// This ConstExportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'ConstExportedWrongComment'"
 const ConstExportedWrongComment = 43
 
+// Block comments aren't checked.
 const (
   // blockConstUnexportedCorrectComment has a correctly formatted comment.
   blockConstUnexportedCorrectComment = 43
-  // This blockConstUnexportedWrongComment has an incorrectly formatted comment.
+  // This blockConstUnexportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'blockConstUnexportedWrongComment'"
   blockConstUnexportedWrongComment = 43
   // BlockConstExportedCorrectComment has a correctly formatted comment.
   BlockConstExportedCorrectComment = 43
-  // This BlockConstExportedWrongComment has an incorrectly formatted comment.
+  // This BlockConstExportedWrongComment has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'BlockConstExportedWrongComment'"
   BlockConstExportedWrongComment = 43
 )
 
-/*
-Type equivalences -- should be ignored in all cases.
-*/
+// multiB and multiA can be commented with any of the declared names.
+var multiA, multiB = 1, 2
 
-type (
-  // equivalenceUnexportedCorrectComment has a correctly formatted comment.
-  blockEquivalenceUnexportedCorrectComment int
+//
+const EmptyComment = 43 // want "empty comment on 'EmptyComment'"
 
-  // This equivalenceUnexportedWrongComment has an incorrectly formatted comment.
-  blockEquivalenceUnexportedWrongComment int
+//nolint:commentmimic
+const machineReadable = 43
 
-  // EquivalenceExportedCorrectComment has a correctly formatted comment.
-  BlockEquivalenceExportedCorrectComment int
+const NotCommented = 43
 
-  // This EquivalenceExportedWrongComment has an incorrectly formatted comment.
-  BlockEquivalenceExportedWrongComment int
-)
+var _ = 43
 `
 
 	ExtraWhitespace = `package a
@@ -0,0 +1,60 @@
+package testdata
+
+const (
+	PackageDocCorrect = `// Package a has a correctly formatted comment.
+package a
+`
+
+	PackageDocMismatch = `// This package has an incorrectly formatted comment. // want "package comment should start with 'Package a' instead of 'This package'"
+package a
+`
+
+	PackageDocWrongName = `// Package b has the wrong name. // want "package comment should start with 'Package a' instead of 'Package b'"
+package a
+`
+
+	PackageDocEmpty = `//
+package a // want "empty comment on 'a'"
+`
+
+	PackageDocMissing = `package a // want "package 'a' should have a package comment"
+`
+
+	PackageDocOtherFile = `package a
+`
+
+	PackageDocDuplicate = `// Package a has a correctly formatted comment.
+package a
+`
+
+	PackageDocConflict = `// Package a has a different comment. // want "package comment conflicts with the package comment in a.go"
+package a
+`
+
+	PackageDocDirective = `//go:generate echo hi
+package a
+`
+
+	PackageDocDirectiveMissing = `//go:generate echo hi
+package a // want "package 'a' should have a package comment"
+`
+
+	PackageDocTestFile = `// This comment is ignored since it's in a test file.
+package a
+`
+
+	CommandDocCorrect = `// Command a has a correctly formatted comment.
+package main
+`
+
+	CommandDocName = `// A has a correctly formatted comment.
+package main
+`
+
+	CommandDocMismatch = `// This command has an incorrectly formatted comment. // want "command comment should start with 'Command' or 'a' instead of 'This'"
+package main
+`
+
+	CommandDocMissing = `package main
+`
+)
@@ -0,0 +1,93 @@
+package testdata
+
+const (
+	ParamNames = `package a
+
+import "context"
+
+type Client struct {
+  timeout int
+}
+
+// Do sends a request using ` + "`ctx`" + `. The opts argument sets the options and
+// the ` + "`err`" + ` result is returned on failure.
+func (c *Client) Do(ctx context.Context, opts []string) (err error) {
+  return nil
+}
+
+// Get sends a request using ` + "`cx`" + `. // want "comment refers to 'cx' which isn't a parameter or result of 'Get'"
+func (client *Client) Get(ctx context.Context) {}
+
+// Post sends a request with the options parameter. // want "comment refers to 'options' which isn't a parameter or result of 'Post'"
+func (c *Client) Post(opts []string) {}
+
+// Put uses ` + "`c`" + `, ` + "`timeout`" + `, ` + "`context`" + `, ` + "`nil`" + `, and ` + "`Do`" + ` which are in scope.
+func (c *Client) Put() {}
+
+// Head uses the max_len parameter. // want "comment refers to 'max_len' which isn't a parameter or result of 'Head'"
+func Head(ctx context.Context) {}
+
+// Load returns the cached result, or decodes the first argument as ` + "`json`" + ` with ` + "`omitempty`" + ` fields.
+func Load(data []byte) error { return nil }
+
+// Delete removes the given argument, see ` + "`xyz`" + ` and the ` + "`Client`" + ` type.
+func Delete(key string) {}
+
+// Patch uses the maxRetrys parameter. // want "comment refers to 'maxRetrys' which isn't a parameter or result of 'Patch'"
+func Patch(maxRetries int) {}
+
+// Map calls ` + "`fn`" + ` for each ` + "`T`" + ` in ` + "`items`" + `.
+func Map[T any](items []T, fn func(T)) {}
+
+// Getter gets things.
+type Getter interface {
+  // Get gets the thing at ` + "`idx`" + `. // want "comment refers to 'idx' which isn't a parameter or result of 'Get'"
+  Get(index int)
+}
+`
+
+	ParamNamesGolden = `package a
+
+import "context"
+
+type Client struct {
+	timeout int
+}
+
+// Do sends a request using ` + "`ctx`" + `. The opts argument sets the options and
+// the ` + "`err`" + ` result is returned on failure.
+func (c *Client) Do(ctx context.Context, opts []string) (err error) {
+	return nil
+}
+
+// Get sends a request using ` + "`ctx`" + `. // want "comment refers to 'cx' which isn't a parameter or result of 'Get'"
+func (client *Client) Get(ctx context.Context) {}
+
+// Post sends a request with the opts parameter. // want "comment refers to 'options' which isn't a parameter or result of 'Post'"
+func (c *Client) Post(opts []string) {}
+
+// Put uses ` + "`c`" + `, ` + "`timeout`" + `, ` + "`context`" + `, ` + "`nil`" + `, and ` + "`Do`" + ` which are in scope.
+func (c *Client) Put() {}
+
+// Head uses the max_len parameter. // want "comment refers to 'max_len' which isn't a parameter or result of 'Head'"
+func Head(ctx context.Context) {}
+
+// Load returns the cached result, or decodes the first argument as ` + "`json`" + ` with ` + "`omitempty`" + ` fields.
+func Load(data []byte) error { return nil }
+
+// Delete removes the given argument, see ` + "`xyz`" + ` and the ` + "`Client`" + ` type.
+func Delete(key string) {}
+
+// Patch uses the maxRetries parameter. // want "comment refers to 'maxRetrys' which isn't a parameter or result of 'Patch'"
+func Patch(maxRetries int) {}
+
+// Map calls ` + "`fn`" + ` for each ` + "`T`" + ` in ` + "`items`" + `.
+func Map[T any](items []T, fn func(T)) {}
+
+// Getter gets things.
+type Getter interface {
+	// Get gets the thing at ` + "`index`" + `. // want "comment refers to 'idx' which isn't a parameter or result of 'Get'"
+	Get(index int)
+}
+`
+)
@@ -0,0 +1,111 @@
+package testdata
+
+const (
+	QualifiedNames = `package a
+
+type Client struct{}
+
+// Client.Get has a comment qualified by the receiver.
+func (c Client) Get() {}
+
+// (*Client).Do has a comment qualified by the pointer receiver.
+func (c *Client) Do() {}
+
+// Client.Put has a comment qualified by the receiver without a pointer.
+func (c *Client) Put() {}
+
+// (*Client).Post has a comment qualified by the receiver with a pointer.
+func (c Client) Post() {}
+
+// Server.Delete has a comment qualified by another type. // want "first word of comment qualifies 'Delete' with 'Server' instead of 'Client'"
+func (c *Client) Delete() {}
+
+// (*Server).Head has a comment qualified by another type. // want "first word of comment qualifies 'Head' with 'Server' instead of 'Client'"
+func (c *Client) Head() {}
+
+// Client.Other has a comment for another method. // want "first word of comment is 'Client.Other' instead of 'Patch'"
+func (c *Client) Patch() {}
+
+// Options has an unqualified comment.
+func (c *Client) Options() {}
+
+// Client.Trace has a qualified comment but isn't a method. // want "first word of comment is 'Client.Trace' instead of 'Trace'"
+func Trace() {}
+
+type list[T any] struct{}
+
+// list[T].Push has a comment qualified by the generic receiver.
+func (l *list[T]) Push(v T) {}
+
+// (*list[T]).Pop has a comment qualified by the generic pointer receiver.
+func (l *list[T]) Pop() {}
+
+// set[T].Len has a comment qualified by another generic type. // want "first word of comment qualifies 'Len' with 'set' instead of 'list'"
+func (l list[T]) Len() int {
+  return 0
+}
+
+// Getter is an interface.
+type Getter interface {
+  // Getter.Get has a comment qualified by the interface.
+  Get()
+
+  // Client.Set has a comment qualified by another type. // want "first word of comment qualifies 'Set' with 'Client' instead of 'Getter'"
+  Set()
+}
+`
+
+	QualifiedNamesGolden = `package a
+
+type Client struct{}
+
+// Client.Get has a comment qualified by the receiver.
+func (c Client) Get() {}
+
+// (*Client).Do has a comment qualified by the pointer receiver.
+func (c *Client) Do() {}
+
+// Client.Put has a comment qualified by the receiver without a pointer.
+func (c *Client) Put() {}
+
+// (*Client).Post has a comment qualified by the receiver with a pointer.
+func (c Client) Post() {}
+
+// Client.Delete has a comment qualified by another type. // want "first word of comment qualifies 'Delete' with 'Server' instead of 'Client'"
+func (c *Client) Delete() {}
+
+// (*Client).Head has a comment qualified by another type. // want "first word of comment qualifies 'Head' with 'Server' instead of 'Client'"
+func (c *Client) Head() {}
+
+// Patch has a comment for another method. // want "first word of comment is 'Client.Other' instead of 'Patch'"
+func (c *Client) Patch() {}
+
+// Options has an unqualified comment.
+func (c *Client) Options() {}
+
+// Trace has a qualified comment but isn't a method. // want "first word of comment is 'Client.Trace' instead of 'Trace'"
+func Trace() {}
+
+type list[T any] struct{}
+
+// list[T].Push has a comment qualified by the generic receiver.
+func (l *list[T]) Push(v T) {}
+
+// (*list[T]).Pop has a comment qualified by the generic pointer receiver.
+func (l *list[T]) Pop() {}
+
+// list[T].Len has a comment qualified by another generic type. // want "first word of comment qualifies 'Len' with 'set' instead of 'list'"
+func (l list[T]) Len() int {
+  return 0
+}
+
+// Getter is an interface.
+type Getter interface {
+  // Getter.Get has a comment qualified by the interface.
+  Get()
+
+  // Getter.Set has a comment qualified by another type. // want "first word of comment qualifies 'Set' with 'Client' instead of 'Getter'"
+  Set()
+}
+`
+)
@@ -0,0 +1,94 @@
+package testdata
+
+const (
+	StructFieldComments = `package a
+
+import "sync"
+
+type Embedded struct{}
+
+type Generic[T any] struct{}
+
+// ExportedStruct has fields with comments.
+type ExportedStruct struct {
+  // FieldA has a correctly formatted comment.
+  FieldA int
+  // This FieldB has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'FieldB'"
+  FieldB int
+  // fieldC has a correctly formatted comment.
+  fieldC int
+  // This fieldD has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'fieldD'"
+  fieldD int
+
+  // FieldF and FieldE can be commented with any of the declared names.
+  FieldE, FieldF int
+
+  //
+  FieldG int // want "empty comment on 'FieldG'"
+
+  //nolint:commentmimic
+  FieldH int // want "exported element 'FieldH' should be commented"
+
+  FieldI int // want "exported element 'FieldI' should be commented"
+  fieldJ int
+
+  // Embedded is commented with the name of the embedded type.
+  Embedded
+  // Mutex guards the fields above.
+  *sync.Mutex
+  // This is a generic embedded type. // want "first word of comment is 'This' instead of 'Generic'"
+  Generic[int]
+  sync.Locker
+
+  // Nested has fields that are checked too.
+  Nested struct {
+    // NestedA has a correctly formatted comment.
+    NestedA int
+    // This NestedB has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'NestedB'"
+    NestedB int
+    NestedC int // want "exported element 'NestedC' should be commented"
+  }
+
+  // nested has fields that are checked but not required.
+  nested struct {
+    NestedD int
+  }
+
+  // Pointer has fields that are checked too.
+  Pointer *struct {
+    PointerA int // want "exported element 'PointerA' should be commented"
+  }
+
+  // Slice has fields that are checked too.
+  Slice []struct {
+    // This SliceA has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'SliceA'"
+    SliceA int
+  }
+
+  // Map has value fields that are checked too.
+  Map map[string]*struct {
+    MapA int // want "exported element 'MapA' should be commented"
+  }
+
+  _ int
+}
+
+type unexportedStruct struct {
+  // This FieldA has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'FieldA'"
+  FieldA int
+  FieldB int
+}
+`
+
+	StructFieldCommentsNotRequired = `package a
+
+// ExportedStruct has fields with comments.
+type ExportedStruct struct {
+  // FieldA has a correctly formatted comment.
+  FieldA int
+  // This FieldB has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'FieldB'"
+  FieldB int
+  FieldC int
+}
+`
+)
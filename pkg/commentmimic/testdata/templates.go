@@ -18,6 +18,60 @@ func (r *{{- .Receiver -}}) {{template "freeFuncDef" .}} { {{template "maybeElem
   return false
 }{{end}}
 
+{{define "GenericReceiverFunction"}}// {{ .Receiver }}
+type {{ .Receiver }}[T any] struct{
+}
+
+{{template "maybeCommentWithError" .}}
+func (r {{ .Receiver -}}[T]) {{template "freeFuncDef" .}} { {{template "maybeElementError" .}}
+  return false
+}{{end}}
+
+{{define "GenericReceiverPtrFunction"}}// {{ .Receiver }}
+type {{ .Receiver }}[T any] struct{
+}
+
+{{template "maybeCommentWithError" .}}
+func (r *{{- .Receiver -}}[T]) {{template "freeFuncDef" .}} { {{template "maybeElementError" .}}
+  return false
+}{{end}}
+
+{{define "GenericListReceiverFunction"}}// {{ .Receiver }}
+type {{ .Receiver }}[K comparable, V any] struct{
+}
+
+{{template "maybeCommentWithError" .}}
+func (r {{ .Receiver -}}[K, V]) {{template "freeFuncDef" .}} { {{template "maybeElementError" .}}
+  return false
+}{{end}}
+
+{{define "GenericListReceiverPtrFunction"}}// {{ .Receiver }}
+type {{ .Receiver }}[K comparable, V any] struct{
+}
+
+{{template "maybeCommentWithError" .}}
+func (r *{{- .Receiver -}}[K, V]) {{template "freeFuncDef" .}} { {{template "maybeElementError" .}}
+  return false
+}{{end}}
+
+{{define "ParenReceiverFunction"}}// {{ .Receiver }}
+type {{ .Receiver }} struct{
+}
+
+{{template "maybeCommentWithError" .}}
+func (r ({{- .Receiver -}})) {{template "freeFuncDef" .}} { {{template "maybeElementError" .}}
+  return false
+}{{end}}
+
+{{define "ParenReceiverPtrFunction"}}// {{ .Receiver }}
+type {{ .Receiver }} struct{
+}
+
+{{template "maybeCommentWithError" .}}
+func (r (*{{- .Receiver -}})) {{template "freeFuncDef" .}} { {{template "maybeElementError" .}}
+  return false
+}{{end}}
+
 {{define "FreeFunction"}}{{template "maybeCommentWithError" .}}
 func {{template "freeFuncDef" .}} { {{template "maybeElementError" .}}
   return false
@@ -0,0 +1,112 @@
+package testdata
+
+const (
+	TestifySuite = `package suite
+
+// Suite is a stub of the testify suite.
+type Suite struct{}
+`
+
+	TestFuncs = `package a_test
+
+import (
+  "testing"
+
+  "github.com/stretchr/testify/suite"
+)
+
+func TestMain(m *testing.M) {}
+
+func TestDoesntNeedComment(t *testing.T) {}
+
+func Test_doesntNeedComment(t *testing.T) {}
+
+func BenchmarkDoesntNeedComment(b *testing.B) {}
+
+func FuzzDoesntNeedComment(f *testing.F) {}
+
+func ExampleDoesntNeedComment() {}
+
+func Example() {}
+
+func TestableClock() int { return 0 } // want "exported element 'TestableClock' should be commented"
+
+func ExampleServer() *testing.T { return nil } // want "exported element 'ExampleServer' should be commented"
+
+type ClientSuite struct {
+  suite.Suite
+}
+
+func TestClientSuite(t *testing.T) {}
+
+func (s *ClientSuite) TestDoesntNeedComment() {}
+
+func (s ClientSuite) TestValueReceiver() {}
+
+func (s *ClientSuite) Testable() {} // want "exported element 'Testable' should be commented"
+
+func (s *ClientSuite) TestWithArg(t *testing.T) {} // want "exported element 'TestWithArg' should be commented"
+
+func (s *ClientSuite) SetupTest() {} // want "exported element 'SetupTest' should be commented"
+
+type NotASuite struct{}
+
+func (n *NotASuite) TestNeedsComment() {} // want "exported element 'TestNeedsComment' should be commented"
+`
+)
+
+const (
+	CustomTestFuncsConfig = `comment-all-exported: true
+test-prefixes: [Spec, Describe]
+test-file-patterns: ["*_test.go", "*_spec.go"]
+`
+
+	CustomTestFuncs = `package a
+
+type clientSpec struct{}
+
+func SpecClient() {}
+
+func DescribeServer(name string) bool { return len(name) > 0 }
+
+func (c *clientSpec) SpecRetries() {}
+
+func Special() {} // want "exported element 'Special' should be commented"
+
+func NewFakeClient() int { return 0 } // want "exported element 'NewFakeClient' should be commented"
+`
+
+	CustomTestFuncsTest = `package a
+
+import (
+  "testing"
+)
+
+func TestClient(t *testing.T) {}
+
+func SpecServer(t *testing.T) {}
+`
+
+	CustomTestFuncsNotTest = `package a
+
+func SpecNotInTestFile() {} // want "exported element 'SpecNotInTestFile' should be commented"
+`
+
+	TestHelpers = `package a
+
+import (
+  "testing"
+)
+
+func TestClient(t *testing.T) {}
+
+func NewFakeClient() int { return 0 }
+
+func TestableClock() int { return 0 }
+`
+
+	TestHelpersNotTest = `package a
+
+func NewClient() int { return 0 } // want "exported element 'NewClient' should be commented"
+`
+)
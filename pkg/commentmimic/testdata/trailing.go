@@ -0,0 +1,90 @@
+package testdata
+
+const (
+	TrailingComments = `package a
+
+func FuncA() {} // FuncA does things. // want "comment for 'FuncA' should be above it instead of at the end of the line"
+
+func FuncB() { // FuncB does things. // want "comment for 'FuncB' should be above it instead of at the end of the line"
+}
+
+func funcC() {} /* funcC does things. */ // want "comment for 'funcC' should be above it instead of at the end of the line"
+
+func FuncD() {} // This isn't a comment for FuncD. // want "exported element 'FuncD' should be commented"
+
+// FuncE has a comment.
+func FuncE() {} // FuncE does things.
+
+type TypeA int // A TypeA is a named type. // want "comment for 'TypeA' should be above it instead of at the end of the line"
+
+type StructA struct { // want "exported element 'StructA' should be commented"
+  FieldA int // FieldA is a field. // want "comment for 'FieldA' should be above it instead of at the end of the line"
+  FieldB int // in seconds.
+
+  // FieldC has a comment.
+  FieldC int // FieldC is a field.
+}
+
+type (
+  StructB struct{} // StructB is a struct. // want "comment for 'StructB' should be above it instead of at the end of the line"
+)
+
+type InterfaceA interface{} // InterfaceA is an interface. // want "comment for 'InterfaceA' should be above it instead of at the end of the line"
+
+// InterfaceB has a comment.
+type InterfaceB interface {
+  MethodA() // MethodA does things. // want "comment for 'MethodA' should be above it instead of at the end of the line"
+}
+
+type AliasA = int // AliasA is an alias. // want "comment for 'AliasA' should be above it instead of at the end of the line"
+`
+
+	TrailingCommentsGolden = `package a
+
+// FuncA does things. // want "comment for 'FuncA' should be above it instead of at the end of the line"
+func FuncA() {}
+
+// FuncB does things. // want "comment for 'FuncB' should be above it instead of at the end of the line"
+func FuncB() {
+}
+
+/* funcC does things. */ // want "comment for 'funcC' should be above it instead of at the end of the line"
+func funcC() {}
+
+// FuncD ...
+func FuncD() {} // This isn't a comment for FuncD. // want "exported element 'FuncD' should be commented"
+
+// FuncE has a comment.
+func FuncE() {} // FuncE does things.
+
+// A TypeA is a named type. // want "comment for 'TypeA' should be above it instead of at the end of the line"
+type TypeA int
+
+// StructA ...
+type StructA struct { // want "exported element 'StructA' should be commented"
+  // FieldA is a field. // want "comment for 'FieldA' should be above it instead of at the end of the line"
+  FieldA int
+  FieldB int // in seconds.
+
+  // FieldC has a comment.
+  FieldC int // FieldC is a field.
+}
+
+type (
+  // StructB is a struct. // want "comment for 'StructB' should be above it instead of at the end of the line"
+  StructB struct{}
+)
+
+// InterfaceA is an interface. // want "comment for 'InterfaceA' should be above it instead of at the end of the line"
+type InterfaceA interface{}
+
+// InterfaceB has a comment.
+type InterfaceB interface {
+  // MethodA does things. // want "comment for 'MethodA' should be above it instead of at the end of the line"
+  MethodA()
+}
+
+// AliasA is an alias. // want "comment for 'AliasA' should be above it instead of at the end of the line"
+type AliasA = int
+`
+)
@@ -0,0 +1,51 @@
+package testdata
+
+const (
+	TypeBlockDoc = `package a
+
+// someStruct is a struct used for passing data.
+type (
+  someStruct struct{}
+)
+
+// This someInterface has an incorrectly formatted comment. // want "first word of comment is 'This' instead of 'someInterface'"
+type (
+  someInterface interface{}
+)
+
+// An someAlias has a correctly formatted comment with a lead word.
+type (
+  someAlias = int
+)
+
+// This comment is on the type block. // want "comment on type block contradicts the comment on 'bothStruct'"
+type (
+  // bothStruct has its own comment.
+  bothStruct struct{}
+)
+
+// A sameStruct is described by both comments.
+type (
+  // sameStruct has its own comment.
+  sameStruct struct{}
+)
+
+// The comment doesn't apply to types in blocks with several types.
+type (
+  // firstStruct has its own comment.
+  firstStruct struct{}
+
+  // secondStruct has its own comment.
+  secondStruct struct{}
+)
+
+// ExportedStruct is a struct used for passing data.
+type (
+  ExportedStruct struct{}
+)
+
+type (
+  MissingStruct struct{} // want "exported element 'MissingStruct' should be commented"
+)
+`
+)
@@ -0,0 +1,61 @@
+package testdata
+
+const (
+	ValueMissingComments = `package a
+
+// ConstCommented has a comment.
+const ConstCommented = 43
+
+const ConstNotCommented = 43 // want "exported element 'ConstNotCommented' should be commented"
+
+const constNotCommented = 43
+
+// VarCommented has a comment.
+var VarCommented = 43
+
+var VarNotCommented = 43 // want "exported element 'VarNotCommented' should be commented"
+
+var varNotCommented = 43
+
+var unexported, Exported = 1, 2 // want "exported element 'Exported' should be commented"
+
+// This comment isn't checked since only missing comments are required.
+const ConstWrongComment = 43
+
+//
+const ConstEmptyComment = 43 // want "empty comment on 'ConstEmptyComment'"
+
+// The block comment doesn't count for the specs.
+const (
+  // BlockConstCommented has a comment.
+  BlockConstCommented = 43
+  BlockConstNotCommented = 43 // want "exported element 'BlockConstNotCommented' should be commented"
+  blockConstNotCommented = 43
+)
+
+var (
+  BlockVarNotCommented = 43 // want "exported element 'BlockVarNotCommented' should be commented"
+)
+`
+
+	ValueBlockDoc = `package a
+
+// Block comments count for every spec without a comment.
+const (
+  // BlockConstCommented has a comment.
+  BlockConstCommented = iota
+  BlockConstNotCommented
+)
+
+// This block comment isn't checked for a matching first word.
+var (
+  // This comment is still checked. // want "first word of comment is 'This' instead of 'BlockVarWrongComment'"
+  BlockVarWrongComment = 43
+  BlockVarNotCommented = 43
+)
+
+const (
+  BlockConstNoBlockComment = 43 // want "exported element 'BlockConstNoBlockComment' should be commented"
+)
+`
+)
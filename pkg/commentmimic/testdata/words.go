@@ -0,0 +1,91 @@
+package testdata
+
+const (
+	FirstWords = `package a
+
+// FuncA's behavior is documented here.
+func FuncA() {}
+
+// FuncB, when called, does things.
+func FuncB() {}
+
+// ` + "`FuncC`" + ` does things.
+func FuncC() {}
+
+// FuncD: does things.
+func FuncD() {}
+
+// ` + "`FuncE`'s" + ` behavior is documented here.
+func FuncE() {}
+
+// FuncF. Does things.
+func FuncF() {}
+
+// Other's behavior is documented here. // want "first word of comment is 'Other's' instead of 'FuncG'"
+func FuncG() {}
+
+// ` + "`Other`," + ` when called, does things. // want "first word of comment is '.Other.,' instead of 'FuncH'"
+func FuncH() {}
+
+// FuncI; does things. // want "first word of comment is 'FuncI;' instead of 'FuncI'"
+func FuncI() {}
+
+// ` + "``" + ` does things. // want "first word of comment is '..' instead of 'FuncJ'"
+func FuncJ() {}
+
+// A ` + "`StructA`" + ` has a comment with a lead word.
+type StructA struct{}
+
+// ` + "`A`" + ` StructB has a comment with a quoted lead word.
+type StructB struct{}
+
+const (
+	// ConstA, ConstB, and ConstC are consts.
+	ConstA, ConstB, ConstC = 1, 2, 3
+)
+`
+
+	FirstWordsGolden = `package a
+
+// FuncA's behavior is documented here.
+func FuncA() {}
+
+// FuncB, when called, does things.
+func FuncB() {}
+
+// ` + "`FuncC`" + ` does things.
+func FuncC() {}
+
+// FuncD: does things.
+func FuncD() {}
+
+// ` + "`FuncE`'s" + ` behavior is documented here.
+func FuncE() {}
+
+// FuncF. Does things.
+func FuncF() {}
+
+// FuncG's behavior is documented here. // want "first word of comment is 'Other's' instead of 'FuncG'"
+func FuncG() {}
+
+// ` + "`FuncH`," + ` when called, does things. // want "first word of comment is '.Other.,' instead of 'FuncH'"
+func FuncH() {}
+
+// FuncI does things. // want "first word of comment is 'FuncI;' instead of 'FuncI'"
+func FuncI() {}
+
+// FuncJ does things. // want "first word of comment is '..' instead of 'FuncJ'"
+func FuncJ() {}
+
+// A ` + "`StructA`" + ` has a comment with a lead word.
+type StructA struct{}
+
+// ` + "`A`" + ` StructB has a comment with a quoted lead word.
+type StructB struct{}
+
+const (
+	// ConstA, ConstB, and ConstC are consts.
+	ConstA, ConstB, ConstC = 1, 2, 3
+)
+`
+)
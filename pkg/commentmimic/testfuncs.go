@@ -0,0 +1,218 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	testingPkgPath = "testing"
+	suitePkgPath   = "github.com/stretchr/testify/suite"
+	suiteTypeName  = "Suite"
+
+	testPrefix     = "Test"
+	examplePrefix  = "Example"
+	testMainName   = "TestMain"
+	testingMainArg = "M"
+
+	defaultTestFilePatterns = "*" + testFileNameSuffix
+)
+
+// testPrefixes maps the prefixes of the functions go test runs to the type in
+// the testing package their only parameter points to.
+var testPrefixes = map[string]string{
+	"Benchmark": "B",
+	"Fuzz":      "F",
+	testPrefix:  "T",
+}
+
+// hasTestName returns true if name is prefix or starts with prefix followed by
+// a character that isn't a lowercase letter. This is the same rule go test uses
+// so TestFoo and Test_foo are tests but Testable isn't.
+func hasTestName(name string, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+
+	if len(name) == len(prefix) {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(name[len(prefix):])
+
+	return !unicode.IsLower(r)
+}
+
+// isNamedType returns true if t is the type named name declared in the package
+// with path pkgPath, or a pointer to it if pointer is set.
+func isNamedType(t types.Type, pointer bool, pkgPath string, name string) bool {
+	if pointer {
+		ptr, ok := t.(*types.Pointer)
+		if !ok {
+			return false
+		}
+
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// fieldCount returns the number of parameters or results in list.
+func fieldCount(list *ast.FieldList) int {
+	if list == nil {
+		return 0
+	}
+
+	return list.NumFields()
+}
+
+// isTestingFunc returns true if fun is a test, benchmark, fuzz test, or
+// TestMain with a single parameter pointing to the matching type from the
+// testing package and no results.
+func isTestingFunc(info *types.Info, fun *ast.FuncDecl) bool {
+	if fieldCount(fun.Type.Params) != 1 || fieldCount(fun.Type.Results) != 0 {
+		return false
+	}
+
+	param := info.TypeOf(fun.Type.Params.List[0].Type)
+	if param == nil {
+		return false
+	}
+
+	name := fun.Name.Name
+
+	if name == testMainName {
+		return isNamedType(param, true, testingPkgPath, testingMainArg)
+	}
+
+	for prefix, arg := range testPrefixes {
+		if hasTestName(name, prefix) &&
+			isNamedType(param, true, testingPkgPath, arg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isExampleFunc returns true if fun is an example go test runs, which has no
+// parameters or results.
+func isExampleFunc(fun *ast.FuncDecl) bool {
+	return hasTestName(fun.Name.Name, examplePrefix) &&
+		fieldCount(fun.Type.Params) == 0 &&
+		fieldCount(fun.Type.Results) == 0
+}
+
+// isSuiteTestMethod returns true if fun is a test method of a testify suite.
+// These are methods starting with Test without parameters or results whose
+// receiver embeds suite.Suite.
+func isSuiteTestMethod(info *types.Info, fun *ast.FuncDecl) bool {
+	if !hasTestName(fun.Name.Name, testPrefix) ||
+		fieldCount(fun.Type.Params) != 0 ||
+		fieldCount(fun.Type.Results) != 0 {
+		return false
+	}
+
+	recv := info.TypeOf(fun.Recv.List[0].Type)
+	if recv == nil {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(recv, true, nil, suiteTypeName)
+
+	field, ok := obj.(*types.Var)
+
+	return ok && field.Embedded() &&
+		isNamedType(field.Type(), false, suitePkgPath, suiteTypeName)
+}
+
+// testMatcher finds test files and the test functions in them.
+type testMatcher struct {
+	// prefixes holds extra prefixes of functions that are tests whatever their
+	// signature, like Spec for BDD style tests.
+	prefixes wordSet
+	// filePatterns holds glob patterns for the base names of test files.
+	filePatterns wordSet
+}
+
+// validate returns an error if one of the test file patterns is malformed.
+func (tm testMatcher) validate() error {
+	for pattern := range tm.filePatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("bad test file pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// isTestFile returns true if the base name of the file fName matches one of the
+// test file patterns.
+func (tm testMatcher) isTestFile(fName string) bool {
+	base := filepath.Base(fName)
+
+	for pattern := range tm.filePatterns {
+		if matched, _ := path.Match(pattern, base); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasCustomPrefix returns true if name starts with one of the extra test
+// prefixes using the same rule go test uses for its prefixes.
+func (tm testMatcher) hasCustomPrefix(name string) bool {
+	for prefix := range tm.prefixes {
+		if hasTestName(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isTestFunc returns true if fun is in a test file and is a function go test
+// runs, a test method of a testify suite, or a function or method starting with
+// one of the extra test prefixes. The signature of fun has to match the kind of
+// test its name says it is, so helpers like TestableClock or ExampleServer
+// fixtures returning values aren't tests. Functions with extra prefixes can
+// have any signature since test frameworks differ in what they expect.
+func (tm testMatcher) isTestFunc(
+	info *types.Info,
+	fset *token.FileSet,
+	fun *ast.FuncDecl,
+) bool {
+	if !tm.isTestFile(fset.Position(fun.Pos()).Filename) {
+		return false
+	}
+
+	if tm.hasCustomPrefix(fun.Name.Name) {
+		return true
+	}
+
+	if fun.Type.TypeParams != nil {
+		return false
+	}
+
+	if fun.Recv != nil {
+		return isSuiteTestMethod(info, fun)
+	}
+
+	return isTestingFunc(info, fun) || isExampleFunc(fun)
+}
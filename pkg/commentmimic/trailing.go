@@ -0,0 +1,179 @@
+package commentmimic
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	trailingCommentTmpl = "comment for '%s' should be above it instead of " +
+		"at the end of the line"
+	moveCommentFixTmpl = "move comment above '%s'"
+
+	ruleTrailing = "trailing"
+)
+
+// fileComments returns the comment groups of all files in pass keyed by the
+// file they're in. Comment groups are sorted by position.
+func fileComments(pass *analysis.Pass) map[*token.File][]*ast.CommentGroup {
+	res := map[*token.File][]*ast.CommentGroup{}
+
+	for _, f := range pass.Files {
+		if tf := pass.Fset.File(f.Pos()); tf != nil {
+			res[tf] = f.Comments
+		}
+	}
+
+	return res
+}
+
+// funcLineComment returns the comment group starting on the same line as the
+// end of the signature of fun, after the signature. This is the comment in
+// declarations like
+//
+//	func Foo() {} // Foo does things.
+//
+// Returns nil if there's no such comment.
+func funcLineComment(r *reporter, fun *ast.FuncDecl) *ast.CommentGroup {
+	sigEnd := fun.Type.End()
+
+	tf := r.pass.Fset.File(sigEnd)
+	if tf == nil {
+		return nil
+	}
+
+	groups := r.comments[tf]
+	idx := sort.Search(len(groups), func(i int) bool {
+		return groups[i].Pos() >= sigEnd
+	})
+
+	if idx >= len(groups) || tf.Line(groups[idx].Pos()) != tf.Line(sigEnd) {
+		return nil
+	}
+
+	return groups[idx]
+}
+
+// checkTrailingComment reports to r if the element with the given name doesn't
+// have a doc comment but has a comment at the end of its line that starts with
+// the name of the element. Such comments were most likely meant as the doc
+// comment of the element. A fix moving the comment above the element is
+// suggested.
+//
+// Elements with a reported comment aren't reported as missing a comment. Does
+// nothing unless trailing comments are checked.
+func checkTrailingComment(
+	r *reporter,
+	elementName string,
+	elementPos token.Pos,
+	doc *ast.CommentGroup,
+	trailing *ast.CommentGroup,
+	leadWords map[string]struct{},
+) {
+	if !r.trailing || doc != nil || trailing == nil ||
+		len(trailing.Text()) == 0 {
+		return
+	}
+
+	words := commentWords(trailing)
+	if !r.words.startsWithName(words, elementName, leadWords) {
+		return
+	}
+
+	r.misplaced[elementPos] = struct{}{}
+
+	r.report(elementName, analysis.Diagnostic{
+		Pos:      trailing.Pos(),
+		End:      trailing.End(),
+		Category: ruleTrailing,
+		Message:  fmt.Sprintf(trailingCommentTmpl, elementName),
+		SuggestedFixes: moveCommentFix(
+			r,
+			elementName,
+			elementPos,
+			trailing,
+		),
+	})
+}
+
+// moveCommentFix returns a fix that moves comment from the end of the line to
+// the line above the element at elementPos, with the same indentation as the
+// element. No fix is returned if the element doesn't start its line or the
+// source file can't be read.
+func moveCommentFix(
+	r *reporter,
+	elementName string,
+	elementPos token.Pos,
+	comment *ast.CommentGroup,
+) []analysis.SuggestedFix {
+	f := r.pass.Fset.File(elementPos)
+	if f == nil {
+		return nil
+	}
+
+	src, ok := r.source(f)
+	if !ok {
+		return nil
+	}
+
+	offset := f.Offset(elementPos)
+	lineStart := f.Offset(f.LineStart(f.Line(elementPos)))
+	commentStart := f.Offset(comment.Pos())
+
+	if offset > len(src) || commentStart > len(src) {
+		return nil
+	}
+
+	indent := src[lineStart:offset]
+	if len(bytes.TrimSpace(indent)) > 0 {
+		return nil
+	}
+
+	// Comments on the same line stay on the same line and each line of the
+	// comment gets the indentation of the element.
+	text := &bytes.Buffer{}
+	line := 0
+
+	for i, c := range comment.List {
+		switch {
+		case i == 0:
+
+		case f.Line(c.Pos()) == line:
+			text.WriteString(" ")
+
+		default:
+			fmt.Fprintf(text, "\n%s", indent)
+		}
+
+		text.WriteString(c.Text)
+
+		line = f.Line(c.End())
+	}
+
+	fmt.Fprintf(text, "\n%s", indent)
+
+	// Remove the whitespace between the code and the comment too.
+	removeStart := len(bytes.TrimRight(src[:commentStart], " \t"))
+
+	return []analysis.SuggestedFix{
+		{
+			Message: fmt.Sprintf(moveCommentFixTmpl, elementName),
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     elementPos,
+					End:     elementPos,
+					NewText: text.Bytes(),
+				},
+				{
+					Pos: f.Pos(removeStart),
+					End: comment.End(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,265 @@
+package commentmimic
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	boolVerbTmpl = "comment on '%s' should use 'reports' instead of '%s' " +
+		"since it returns a bool"
+	constructorDocTmpl = "comment on constructor '%s' should mention the " +
+		"type '%s' it returns"
+	errorDocTmpl = "comment on '%s' should describe when it returns an error"
+
+	boolVerb            = "reports"
+	boolVerbReplaced    = "returns"
+	boolVerbConjunction = "whether"
+	constructorPrefix   = "New"
+
+	ruleBoolVerb       = "bool-verb"
+	ruleConstructorDoc = "constructor-doc"
+	ruleErrorDoc       = "error-doc"
+)
+
+// errorWordPrefixes are the prefixes of words describing a failure, like
+// "error", "errors", "fails", or "failure".
+var errorWordPrefixes = []string{"err", "fail"}
+
+// resultTypes returns the type of each result of ft, with one entry per name
+// if several results share a type.
+func resultTypes(ft *ast.FuncType) []ast.Expr {
+	if ft.Results == nil {
+		return nil
+	}
+
+	var res []ast.Expr
+
+	for _, field := range ft.Results.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+
+		for i := 0; i < n; i++ {
+			res = append(res, field.Type)
+		}
+	}
+
+	return res
+}
+
+// isUniverseType returns true if expr has the type of the predeclared
+// identifier name, like bool or error. Named types with the same underlying
+// type don't count.
+func isUniverseType(info *types.Info, expr ast.Expr, name string) bool {
+	t := info.TypeOf(expr)
+	if t == nil {
+		return false
+	}
+
+	return types.Identical(t, types.Universe.Lookup(name).Type())
+}
+
+// constructedType returns the name of the type a constructor with the results
+// in results returns. Constructors return T or *T, optionally followed by an
+// error. Returns false if the results don't have this form.
+func constructedType(info *types.Info, results []ast.Expr) (string, bool) {
+	switch {
+	case len(results) == 1:
+
+	case len(results) == 2 && isUniverseType(info, results[1], "error"):
+
+	default:
+		return "", false
+	}
+
+	expr := results[0]
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	// Strip type arguments and the package name of imported types.
+	ident := receiverIdent(expr)
+	if ident == nil {
+		return "", false
+	}
+
+	return ident.Name, true
+}
+
+// isConstructorName returns true if name is New or starts with New followed by
+// an uppercase letter, like NewClient.
+func isConstructorName(name string) bool {
+	rest := strings.TrimPrefix(name, constructorPrefix)
+	if len(rest) == len(name) {
+		return false
+	}
+
+	return len(rest) == 0 || unicode.IsUpper([]rune(rest)[0])
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// mentionsName returns true if one of words refers to name. Words may have
+// surrounding punctuation, be possessive, be qualified by a package name, or
+// be doc links, like "[pkg.Name]" or "Name's".
+func mentionsName(words []string, name string) bool {
+	for _, word := range words {
+		for _, possessive := range possessiveSuffixes {
+			word = strings.TrimSuffix(
+				strings.TrimRight(word, trailingPunctuation),
+				possessive,
+			)
+		}
+
+		word = strings.TrimFunc(word, func(r rune) bool {
+			return !isIdentRune(r)
+		})
+
+		if word == name || strings.HasSuffix(word, "."+name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// describesError returns true if one of words describes a failure.
+func describesError(words []string) bool {
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimLeftFunc(word, func(r rune) bool {
+			return !isIdentRune(r)
+		}))
+
+		for _, prefix := range errorWordPrefixes {
+			if strings.HasPrefix(word, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// nameWordIndex returns the index of the name of the element in words. The
+// name may come after one of leadWords. Returns false if the comment doesn't
+// start with the name.
+func nameWordIndex(
+	r *reporter,
+	words []string,
+	name string,
+	leadWords map[string]struct{},
+) (int, bool) {
+	if !r.words.startsWithName(words, name, leadWords) {
+		return 0, false
+	}
+
+	if r.words.normalize(words[0]) == name {
+		return 0, true
+	}
+
+	return 1, true
+}
+
+// checkConventions checks the comment on fun follows the conventions for
+// functions with its signature that are enabled in m:
+//
+//   - functions returning a single bool say "Foo reports whether..."
+//   - constructors named New or NewFoo returning T or *T mention T
+//   - functions returning only an error describe when the error is returned
+//
+// Comments that don't start with the name of the function are skipped since
+// they're already reported as a mismatch.
+func (m mimic) checkConventions(r *reporter, fun *ast.FuncDecl) {
+	if fun.Doc == nil {
+		return
+	}
+
+	name := fun.Name.Name
+	words := commentWords(fun.Doc)
+
+	idx, ok := nameWordIndex(r, words, name, m.leadWords.funcs)
+	if !ok {
+		return
+	}
+
+	results := resultTypes(fun.Type)
+	info := r.pass.TypesInfo
+
+	if m.checkBoolVerb && len(results) == 1 &&
+		isUniverseType(info, results[0], "bool") {
+		checkBoolVerb(r, name, fun.Doc, words, idx+1)
+	}
+
+	if m.checkConstructorDoc && isConstructorName(name) {
+		typeName, ok := constructedType(info, results)
+		if ok && !mentionsName(words[idx+1:], typeName) {
+			r.report(name, analysis.Diagnostic{
+				Pos:      fun.Doc.Pos(),
+				End:      fun.Doc.End(),
+				Category: ruleConstructorDoc,
+				Message:  fmt.Sprintf(constructorDocTmpl, name, typeName),
+			})
+		}
+	}
+
+	if m.checkErrorDoc && len(results) == 1 &&
+		isUniverseType(info, results[0], "error") &&
+		!describesError(words[idx+1:]) {
+		r.report(name, analysis.Diagnostic{
+			Pos:      fun.Doc.Pos(),
+			End:      fun.Doc.End(),
+			Category: ruleErrorDoc,
+			Message:  fmt.Sprintf(errorDocTmpl, name),
+		})
+	}
+}
+
+// checkBoolVerb reports to r if the word at idx in words, the verb after the
+// name of the function, isn't "reports". If the comment says "returns whether"
+// a fix replacing "returns" with "reports" is suggested.
+func checkBoolVerb(
+	r *reporter,
+	name string,
+	comment *ast.CommentGroup,
+	words []string,
+	idx int,
+) {
+	if idx >= len(words) || words[idx] == boolVerb {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      comment.Pos(),
+		End:      comment.End(),
+		Category: ruleBoolVerb,
+		Message:  fmt.Sprintf(boolVerbTmpl, name, words[idx]),
+	}
+
+	start, end, ok := commentWordPos(comment, idx)
+	if ok && words[idx] == boolVerbReplaced && idx+1 < len(words) &&
+		words[idx+1] == boolVerbConjunction {
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf(replaceWordFixTmpl, words[idx], boolVerb),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     start,
+						End:     end,
+						NewText: []byte(boolVerb),
+					},
+				},
+			},
+		}
+	}
+
+	r.report(name, diag)
+}
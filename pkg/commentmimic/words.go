@@ -0,0 +1,93 @@
+package commentmimic
+
+import (
+	"strings"
+)
+
+const (
+	trailingPunctuation = ",:."
+	backtick            = "`"
+)
+
+// possessiveSuffixes are the suffixes removed from words in possessive form.
+// Both the ASCII and typographic apostrophes are handled.
+var possessiveSuffixes = []string{"'s", "’s"}
+
+// wordNormalizer removes characters around the first words of comments before
+// they're compared to element names, so comments like "Foo's behavior...",
+// "Foo, when called...", or "`Foo` returns..." match the element Foo. Nothing
+// is removed by default.
+type wordNormalizer struct {
+	// backticks removes backticks around the word.
+	backticks bool
+	// punctuation removes trailing commas, colons, and periods.
+	punctuation bool
+	// possessive removes a trailing "'s".
+	possessive bool
+}
+
+func (wn wordNormalizer) trimPossessive(word string) string {
+	if !wn.possessive {
+		return word
+	}
+
+	for _, suffix := range possessiveSuffixes {
+		if trimmed := strings.TrimSuffix(word, suffix); len(trimmed) > 0 {
+			word = trimmed
+		}
+	}
+
+	return word
+}
+
+// core returns the part of word that's compared to element names along with
+// the byte offset of the part in word.
+func (wn wordNormalizer) core(word string) (string, int) {
+	offset := 0
+
+	if wn.punctuation {
+		word = strings.TrimRight(word, trailingPunctuation)
+	}
+
+	word = wn.trimPossessive(word)
+
+	if wn.backticks && len(word) > 2 &&
+		strings.HasPrefix(word, backtick) &&
+		strings.HasSuffix(word, backtick) {
+		word = word[1 : len(word)-1]
+		offset++
+	}
+
+	// The possessive form may be inside the backticks too.
+	return wn.trimPossessive(word), offset
+}
+
+// normalize returns the part of word that's compared to element names.
+func (wn wordNormalizer) normalize(word string) string {
+	res, _ := wn.core(word)
+	return res
+}
+
+// startsWithName returns true if the first word in words is name or if the
+// first word is one of leadWords and the second word is name. Words are
+// normalized before comparing them.
+func (wn wordNormalizer) startsWithName(
+	words []string,
+	name string,
+	leadWords map[string]struct{},
+) bool {
+	if len(words) > 0 && wn.normalize(words[0]) == name {
+		return true
+	}
+
+	// See if this comment has a first word that matches a lead word and then
+	// check if the second word matches the element name.
+	if len(words) > 1 {
+		_, ok := leadWords[wn.normalize(words[0])]
+		if ok && wn.normalize(words[1]) == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/ashmrtn/commentmimic/pkg/commentmimic"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifInfoURI = "https://github.com/ashmrtn/commentmimic"
+	srcRootID    = "%SRCROOT%"
+)
+
+// The types below are the subset of the SARIF 2.1.0 format needed to report
+// findings.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool                   `json:"tool"`
+	BaseIDs    map[string]sarifArtifactLoc `json:"originalUriBaseIds,omitempty"`
+	Results    []sarifResult               `json:"results"`
+	ColumnKind string                      `json:"columnKind"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	Help                 sarifMessage       `json:"help"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLoc `json:"artifactLocation"`
+	Region           sarifRegion      `json:"region"`
+}
+
+type sarifArtifactLoc struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// sarifWriter turns diagnostics into SARIF results. Columns in SARIF are
+// counted in UTF-16 code units by default so the source of files with findings
+// is read to convert the byte offsets used by go/token.
+type sarifWriter struct {
+	root      string
+	rules     []commentmimic.Rule
+	ruleIndex map[string]int
+	results   []sarifResult
+	sources   map[string][]byte
+}
+
+func newSARIFWriter(root string) *sarifWriter {
+	w := &sarifWriter{
+		root:      root,
+		rules:     commentmimic.Rules(),
+		ruleIndex: map[string]int{},
+		sources:   map[string][]byte{},
+	}
+
+	for i, r := range w.rules {
+		w.ruleIndex[r.ID] = i
+	}
+
+	return w
+}
+
+// column returns the 1-based column of pos counted in UTF-16 code units.
+func (w *sarifWriter) column(pos token.Position) int {
+	src, ok := w.sources[pos.Filename]
+	if !ok {
+		// Fall back to byte columns if the source can't be read.
+		src, _ = os.ReadFile(pos.Filename)
+		w.sources[pos.Filename] = src
+	}
+
+	lineStart := pos.Offset - (pos.Column - 1)
+	if lineStart < 0 || pos.Offset > len(src) {
+		return pos.Column
+	}
+
+	col := 1
+
+	for prefix := src[lineStart:pos.Offset]; len(prefix) > 0; {
+		r, size := utf8.DecodeRune(prefix)
+		prefix = prefix[size:]
+
+		col += len(utf16.Encode([]rune{r}))
+	}
+
+	return col
+}
+
+// location returns the location of the file with the given name. Files under
+// the root directory are relative to it.
+func (w *sarifWriter) location(name string) sarifArtifactLoc {
+	if rel, err := filepath.Rel(w.root, name); err == nil &&
+		!strings.HasPrefix(rel, "..") {
+		return sarifArtifactLoc{
+			URI:       filepath.ToSlash(rel),
+			URIBaseID: srcRootID,
+		}
+	}
+
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(name)}
+
+	return sarifArtifactLoc{URI: u.String()}
+}
+
+func (w *sarifWriter) add(fset *token.FileSet, d analysis.Diagnostic) {
+	start := fset.Position(d.Pos)
+	end := start
+
+	if d.End.IsValid() {
+		end = fset.Position(d.End)
+	}
+
+	idx, ok := w.ruleIndex[d.Category]
+	if !ok {
+		idx = -1
+	}
+
+	w.results = append(w.results, sarifResult{
+		RuleID:    d.Category,
+		RuleIndex: idx,
+		Level:     "warning",
+		Message:   sarifMessage{Text: d.Message},
+		Locations: []sarifLocation{
+			{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: w.location(start.Filename),
+					Region: sarifRegion{
+						StartLine:   start.Line,
+						StartColumn: w.column(start),
+						EndLine:     end.Line,
+						EndColumn:   w.column(end),
+					},
+				},
+			},
+		},
+	})
+}
+
+// write outputs a SARIF log with all results added so far. Results are sorted
+// by location so the output is stable.
+func (w *sarifWriter) write(out io.Writer) error {
+	sort.SliceStable(w.results, func(i, j int) bool {
+		a := w.results[i].Locations[0].PhysicalLocation
+		b := w.results[j].Locations[0].PhysicalLocation
+
+		if a.ArtifactLocation.URI != b.ArtifactLocation.URI {
+			return a.ArtifactLocation.URI < b.ArtifactLocation.URI
+		}
+
+		if a.Region.StartLine != b.Region.StartLine {
+			return a.Region.StartLine < b.Region.StartLine
+		}
+
+		return a.Region.StartColumn < b.Region.StartColumn
+	})
+
+	rules := make([]sarifRule, 0, len(w.rules))
+
+	for _, r := range w.rules {
+		rules = append(rules, sarifRule{
+			ID:                   r.ID,
+			Name:                 r.Name,
+			ShortDescription:     sarifMessage{Text: r.Description},
+			Help:                 sarifMessage{Text: r.Help},
+			DefaultConfiguration: sarifConfiguration{Level: "warning"},
+		})
+	}
+
+	root := url.URL{
+		Scheme: "file",
+		Path:   filepath.ToSlash(w.root) + "/",
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "commentmimic",
+						InformationURI: sarifInfoURI,
+						Rules:          rules,
+					},
+				},
+				BaseIDs: map[string]sarifArtifactLoc{
+					srcRootID: {URI: root.String()},
+				},
+				Results:    w.results,
+				ColumnKind: "utf16CodeUnits",
+			},
+		},
+	}
+
+	// Results must be an array even if there are none.
+	if log.Runs[0].Results == nil {
+		log.Runs[0].Results = []sarifResult{}
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(log); err != nil {
+		return fmt.Errorf("encoding SARIF: %w", err)
+	}
+
+	return nil
+}